@@ -0,0 +1,8 @@
+# Look up a database managed in another Terraform state.
+data "sys11dbaas_database" "shared" {
+  name = "shared-postgresql"
+}
+
+output "shared_hostname" {
+  value = data.sys11dbaas_database.shared.application_config.private_networking.hostname
+}
@@ -0,0 +1,9 @@
+# All PostgreSQL databases of the project that are ready.
+data "sys11dbaas_databases" "ready" {
+  type   = "postgresql"
+  status = "Ready"
+}
+
+output "database_names" {
+  value = [for db in data.sys11dbaas_databases.ready.databases : db.name]
+}
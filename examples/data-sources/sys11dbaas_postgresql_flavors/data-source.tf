@@ -1 +1,11 @@
 data "sys11dbaas_postgresql_flavors" "all" {}
+
+# The smallest flavor with at least 4 vCPUs and 16 GiB of memory.
+data "sys11dbaas_postgresql_flavors" "medium" {
+  min_vcpus  = 4
+  min_ram_gb = 16
+}
+
+output "medium_flavor" {
+  value = data.sys11dbaas_postgresql_flavors.medium.smallest_matching
+}
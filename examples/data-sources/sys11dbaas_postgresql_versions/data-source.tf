@@ -1 +1,14 @@
 data "sys11dbaas_postgresql_versions" "all" {}
+
+# The newest minor version of PostgreSQL 17.
+data "sys11dbaas_postgresql_versions" "seventeen" {
+  major = 17
+}
+
+output "default_version" {
+  value = data.sys11dbaas_postgresql_versions.all.default
+}
+
+output "latest_17_version" {
+  value = data.sys11dbaas_postgresql_versions.seventeen.latest
+}
@@ -0,0 +1,15 @@
+# List the databases of the project. To generate configuration and import
+# blocks for them, run
+#   terraform query -generate-config-out=generated.tf
+list "sys11dbaas_database" "all" {
+  provider         = sys11dbaas
+  include_resource = true
+}
+
+list "sys11dbaas_database" "reporting" {
+  provider = sys11dbaas
+
+  config {
+    name_regex = "^reporting-"
+  }
+}
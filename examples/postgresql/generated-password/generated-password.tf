@@ -0,0 +1,25 @@
+resource "sys11dbaas_database" "postgresql" {
+  name = "example-postgresql"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.5
+
+    # Without password and password_wo, a random password is generated on
+    # creation and stored in state.
+    password_policy = {
+      length  = 40
+      special = false
+    }
+  }
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+
+output "database_password" {
+  value     = sys11dbaas_database.postgresql.application_config.password
+  sensitive = true
+}
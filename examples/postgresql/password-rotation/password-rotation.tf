@@ -0,0 +1,24 @@
+# Rotate the generated admin password every 90 days.
+resource "time_rotating" "database_password" {
+  rotation_days = 90
+}
+
+resource "sys11dbaas_database" "postgresql" {
+  name = "example-postgresql"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.5
+
+    password_rotation_trigger = time_rotating.database_password.id
+  }
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+
+output "database_password_last_rotated_at" {
+  value = sys11dbaas_database.postgresql.application_config.password_last_rotated_at
+}
@@ -0,0 +1,24 @@
+variable "database_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "sys11dbaas_database" "postgresql" {
+  name = "example-postgresql"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.5
+
+    # The password is never stored in state. Increase the version to send a
+    # changed password to the database.
+    password_wo         = var.database_password
+    password_wo_version = 1
+  }
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
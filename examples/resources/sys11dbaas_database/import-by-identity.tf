@@ -0,0 +1,10 @@
+# With Terraform 1.12 and later, databases can be imported by their identity.
+# The organization and project default to the ones of the provider.
+import {
+  to = sys11dbaas_database.example
+  identity = {
+    organization = "my-organization"
+    project      = "my-project"
+    uuid         = "2c5a5a8e-8a0b-4b5e-9d3f-0f6f2a7c1b4e"
+  }
+}
@@ -0,0 +1,8 @@
+# Databases of the provider's project can be imported by uuid or by name.
+terraform import sys11dbaas_database.example 2c5a5a8e-8a0b-4b5e-9d3f-0f6f2a7c1b4e
+terraform import sys11dbaas_database.example my-database
+
+# Databases of another project or organization are imported by
+# project/uuid or organization/project/uuid, where the uuid may also be a name.
+terraform import sys11dbaas_database.example my-project/my-database
+terraform import sys11dbaas_database.example my-organization/my-project/2c5a5a8e-8a0b-4b5e-9d3f-0f6f2a7c1b4e
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// catalogIDs returns the IDs of all entries of a catalog.
+func catalogIDs[T any](entries []T, id func(T) string) []string {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, id(entry))
+	}
+
+	return ids
+}
+
+// catalogValueDetail describes why value is not in the catalog of valid
+// values, suggesting the closest valid value.
+func catalogValueDetail(kind, value string, valid []string) string {
+	detail := fmt.Sprintf("%q is not an available %s.", value, kind)
+	if suggestion := closestMatch(value, valid); suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+
+	return detail + " Available values: " + strings.Join(valid, ", ")
+}
+
+// closestMatch returns the candidate with the smallest edit distance to value,
+// ignoring case. Candidates that share less than half of value are not
+// considered a match.
+func closestMatch(value string, candidates []string) string {
+	best, bestDistance := "", len(value)/2+1
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(value), strings.ToLower(candidate))
+		if distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	return best
+}
+
+// levenshtein returns the number of single character edits needed to turn a
+// into b.
+func levenshtein(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
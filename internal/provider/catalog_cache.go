@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v2 "github.com/syseleven/sys11dbaas-sdk/database/v2"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultCatalogCacheTTL bounds how long a catalog is reused. Catalogs change
+// rarely, a provider process usually lives for a single Terraform command.
+const defaultCatalogCacheTTL = 5 * time.Minute
+
+// catalogCache caches the catalogs of the API, i.e. flavors, regions,
+// versions and features, for all data sources and resources of a provider.
+// Concurrent lookups of the same catalog share a single API request. Failed
+// requests are not cached.
+type catalogCache struct {
+	client       *v2.TypedClient
+	organization string
+	project      string
+	ttl          time.Duration
+	now          func() time.Time
+
+	group   singleflight.Group
+	mu      sync.Mutex
+	entries map[string]catalogCacheEntry
+}
+
+type catalogCacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+func newCatalogCache(client *v2.TypedClient, organization, project string, ttl time.Duration) *catalogCache {
+	return &catalogCache{
+		client:       client,
+		organization: organization,
+		project:      project,
+		ttl:          ttl,
+		now:          time.Now,
+		entries:      map[string]catalogCacheEntry{},
+	}
+}
+
+// PostgreSQLFlavors returns the available PostgreSQL flavors.
+func (c *catalogCache) PostgreSQLFlavors(ctx context.Context) ([]v2.PostgreSQLFlavor, error) {
+	return cachedCatalog(ctx, c, "postgresql_flavors", c.client.ListPostgreSQLFlavors)
+}
+
+// PostgreSQLRegions returns the available PostgreSQL regions.
+func (c *catalogCache) PostgreSQLRegions(ctx context.Context) ([]v2.PostgreSQLRegion, error) {
+	return cachedCatalog(ctx, c, "postgresql_regions", c.client.ListPostgreSQLRegions)
+}
+
+// PostgreSQLVersions returns the available PostgreSQL versions.
+func (c *catalogCache) PostgreSQLVersions(ctx context.Context) ([]v2.PostgreSQLVersion, error) {
+	return cachedCatalog(ctx, c, "postgresql_versions", c.client.ListPostgreSQLVersions)
+}
+
+// Features returns the available database features.
+func (c *catalogCache) Features(ctx context.Context) ([]v2.Feature, error) {
+	return cachedCatalog(ctx, c, "features", c.client.ListFeatures)
+}
+
+// cachedCatalog returns the catalog stored under key, calling list if it is
+// missing or expired. The request is not cancelled when ctx is, as other
+// callers may be waiting for it, but the caller stops waiting.
+func cachedCatalog[T any](ctx context.Context, c *catalogCache, key string, list func(ctx context.Context, organization, project string) ([]T, error)) ([]T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expires) {
+		return entry.value.([]T), nil
+	}
+
+	result := c.group.DoChan(key, func() (any, error) {
+		entries, err := list(context.WithoutCancel(ctx), c.organization, c.project)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.entries[key] = catalogCacheEntry{value: entries, expires: c.now().Add(c.ttl)}
+		c.mu.Unlock()
+
+		return entries, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-result:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return r.Val.([]T), nil
+	}
+}
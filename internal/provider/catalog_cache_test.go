@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sys11dbaassdk "github.com/syseleven/sys11dbaas-sdk"
+)
+
+// catalogServer serves a flavor catalog, failing the first failures requests.
+func catalogServer(t *testing.T, failures int32) (*catalogCache, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Slow enough for concurrent lookups to overlap.
+		time.Sleep(20 * time.Millisecond)
+		if requests.Add(1) <= failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "SCS-2V-4-50n", "description": "2/4/50", "default": true}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := sys11dbaassdk.NewClient(server.URL, sys11dbaassdk.WithApiKey("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return newCatalogCache(client.V2(), "organization", "project", time.Minute), &requests
+}
+
+func TestCatalogCacheDeduplicatesConcurrentLookups(t *testing.T) {
+	cache, requests := catalogServer(t, 0)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			flavors, err := cache.PostgreSQLFlavors(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(flavors) != 1 || flavors[0].Id != "SCS-2V-4-50n" {
+				t.Errorf("unexpected flavors %v", flavors)
+			}
+		})
+	}
+	wg.Wait()
+
+	if _, err := cache.PostgreSQLFlavors(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestCatalogCacheExpires(t *testing.T) {
+	cache, requests := catalogServer(t, 0)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	for range 2 {
+		if _, err := cache.PostgreSQLFlavors(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request before the ttl expired, got %d", got)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := cache.PostgreSQLFlavors(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests after the ttl expired, got %d", got)
+	}
+}
+
+func TestCatalogCacheDoesNotCacheErrors(t *testing.T) {
+	cache, requests := catalogServer(t, 1)
+
+	if _, err := cache.PostgreSQLFlavors(context.Background()); err == nil {
+		t.Fatal("expected the first lookup to fail")
+	}
+	if _, err := cache.PostgreSQLFlavors(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestCatalogCacheCancelled(t *testing.T) {
+	cache, _ := catalogServer(t, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cache.PostgreSQLFlavors(ctx); err == nil {
+		t.Error("expected a cancelled lookup to fail")
+	}
+
+	// The request started by the cancelled lookup still fills the cache.
+	if _, err := cache.PostgreSQLFlavors(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// catalogEntryModel maps an entry of a catalog, like a flavor or a version.
+type catalogEntryModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Default     types.Bool   `tfsdk:"default"`
+}
+
+// sizedCatalogEntryModel maps an entry of a sized catalog, like a flavor.
+type sizedCatalogEntryModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Description types.String  `tfsdk:"description"`
+	Default     types.Bool    `tfsdk:"default"`
+	VCPUs       types.Int64   `tfsdk:"vcpus"`
+	RAMGB       types.Float64 `tfsdk:"ram_gb"`
+	DiskGB      types.Int64   `tfsdk:"disk_gb"`
+	DiskType    types.String  `tfsdk:"disk_type"`
+}
+
+func newSizedCatalogEntryModel(entry catalogEntryModel) sizedCatalogEntryModel {
+	model := sizedCatalogEntryModel{
+		ID:          entry.ID,
+		Description: entry.Description,
+		Default:     entry.Default,
+		VCPUs:       types.Int64Null(),
+		RAMGB:       types.Float64Null(),
+		DiskGB:      types.Int64Null(),
+		DiskType:    types.StringNull(),
+	}
+
+	if capacity, ok := parseFlavorName(entry.ID.ValueString()); ok {
+		model.VCPUs = types.Int64Value(capacity.vcpus)
+		model.RAMGB = types.Float64Value(capacity.ramGB)
+		if capacity.diskGB > 0 {
+			model.DiskGB = types.Int64Value(capacity.diskGB)
+		}
+		if capacity.diskType != "" {
+			model.DiskType = types.StringValue(capacity.diskType)
+		}
+	}
+
+	return model
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &catalogDataSource[any]{}
+	_ datasource.DataSourceWithConfigure      = &catalogDataSource[any]{}
+	_ datasource.DataSourceWithValidateConfig = &catalogDataSource[any]{}
+)
+
+// catalogDataSource lists the entries of a catalog of the API. The catalogs
+// only differ in their name and the call listing them, so a new catalog is
+// added by describing it in a catalogDataSource.
+type catalogDataSource[T any] struct {
+	// typeName is appended to the provider type name, e.g. "postgresql_flavors".
+	typeName string
+	// attribute holds the list of entries, e.g. "flavors".
+	attribute string
+	// kind is the singular name of an entry used in descriptions, e.g. "flavor".
+	kind string
+	// engine is the database the catalog belongs to, e.g. "PostgreSQL".
+	engine string
+
+	// versioned catalogs are ordered by version instead of id. They can be
+	// filtered by major version and expose their latest version.
+	versioned bool
+	// sized catalogs list machine sizes named after the SCS flavor naming
+	// standard. Their entries expose the capacity encoded in the name, which
+	// they can be filtered by.
+	sized bool
+
+	list  func(ctx context.Context, catalogs *catalogCache) ([]T, error)
+	entry func(T) catalogEntryModel
+
+	catalogs *catalogCache
+}
+
+// Configure adds the provider configured catalogs to the data source.
+func (d *catalogDataSource[T]) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*sys11DBaaSProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sys11DBaaSProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.catalogs = providerData.catalogs
+}
+
+// Metadata returns the data source type name.
+func (d *catalogDataSource[T]) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+// Schema defines the schema for the data source.
+func (d *catalogDataSource[T]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	order := "id"
+	if d.versioned {
+		order = "version"
+	}
+
+	entryAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("Textual identifier of the %s.", d.kind),
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: fmt.Sprintf("Description of the %s.", d.kind),
+			Computed:    true,
+		},
+		"default": schema.BoolAttribute{
+			Description: fmt.Sprintf("In case this %s is the default, this field is true.", d.kind),
+			Computed:    true,
+		},
+	}
+	if d.sized {
+		entryAttributes["vcpus"] = schema.Int64Attribute{
+			Description: "Number of vCPUs. Null if the name does not follow the SCS flavor naming standard.",
+			Computed:    true,
+		}
+		entryAttributes["ram_gb"] = schema.Float64Attribute{
+			Description: "Memory in GiB. Null if the name does not follow the SCS flavor naming standard.",
+			Computed:    true,
+		}
+		entryAttributes["disk_gb"] = schema.Int64Attribute{
+			Description: "Size of the root disk in GB. Null if there is no disk or the name does not specify it.",
+			Computed:    true,
+		}
+		entryAttributes["disk_type"] = schema.StringAttribute{
+			Description: "Type of the root disk, one of 'network', 'local-hdd', 'local-ssd' or 'local-nvme'. Null if the name does not specify it.",
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Fetches the list of available %s %ss, ordered by %s.", d.engine, d.kind, order),
+		Attributes: map[string]schema.Attribute{
+			"id_regex": schema.StringAttribute{
+				Description: fmt.Sprintf("Regular expression the id of the listed %ss must match.", d.kind),
+				Optional:    true,
+			},
+			"default_only": schema.BoolAttribute{
+				Description: fmt.Sprintf("Only list the default %s.", d.kind),
+				Optional:    true,
+			},
+			"default": schema.StringAttribute{
+				Description: fmt.Sprintf("Textual identifier of the default %s, regardless of the filters. Null if there is no default.", d.kind),
+				Computed:    true,
+			},
+			d.attribute: schema.ListNestedAttribute{
+				Description: fmt.Sprintf("List of %ss.", d.kind),
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: entryAttributes,
+				},
+			},
+		},
+	}
+
+	if d.versioned {
+		resp.Schema.Attributes["major"] = schema.Int64Attribute{
+			Description: fmt.Sprintf("Only list the %ss of this major version.", d.kind),
+			Optional:    true,
+		}
+		resp.Schema.Attributes["latest"] = schema.StringAttribute{
+			Description: fmt.Sprintf("Textual identifier of the latest listed %s. Null if no %s matches the filters.", d.kind, d.kind),
+			Computed:    true,
+		}
+	}
+
+	if d.sized {
+		resp.Schema.Attributes["min_vcpus"] = schema.Int64Attribute{
+			Description: fmt.Sprintf("Only list the %ss with at least this number of vCPUs.", d.kind),
+			Optional:    true,
+		}
+		resp.Schema.Attributes["min_ram_gb"] = schema.Float64Attribute{
+			Description: fmt.Sprintf("Only list the %ss with at least this amount of memory in GiB.", d.kind),
+			Optional:    true,
+		}
+		resp.Schema.Attributes["smallest_matching"] = schema.StringAttribute{
+			Description: fmt.Sprintf("Textual identifier of the listed %s with the fewest vCPUs, then the least memory and disk. Null if no %s matches the filters.", d.kind, d.kind),
+			Computed:    true,
+		}
+	}
+}
+
+// ValidateConfig checks that id_regex is a valid regular expression.
+func (d *catalogDataSource[T]) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateRegexAttribute(ctx, req.Config, path.Root("id_regex"))...)
+}
+
+// validateRegexAttribute checks that the string attribute at p is a valid
+// regular expression, if it is set.
+func validateRegexAttribute(ctx context.Context, config tfsdk.Config, p path.Path) diag.Diagnostics {
+	var value types.String
+	diags := config.GetAttribute(ctx, p, &value)
+	if diags.HasError() || value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	if _, err := regexp.Compile(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid regular expression",
+			fmt.Sprintf("The %s is not a valid regular expression: %s", p, err.Error()),
+		)
+	}
+
+	return diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *catalogDataSource[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var idRegex types.String
+	var defaultOnly types.Bool
+	major := types.Int64Null()
+	minVCPUs, minRAMGB := types.Int64Null(), types.Float64Null()
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id_regex"), &idRegex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_only"), &defaultOnly)...)
+	if d.versioned {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("major"), &major)...)
+	}
+	if d.sized {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_vcpus"), &minVCPUs)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_ram_gb"), &minRAMGB)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A regular expression that is only known at apply time skipped
+	// validation, so it is compiled here without panicking.
+	var idPattern *regexp.Regexp
+	if !idRegex.IsNull() {
+		var err error
+		idPattern, err = regexp.Compile(idRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id_regex"),
+				"Invalid regular expression",
+				fmt.Sprintf("The id_regex is not a valid regular expression: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	catalog, err := d.list(ctx, d.catalogs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read %s %ss", d.engine, d.kind),
+			err.Error(),
+		)
+		return
+	}
+
+	entries := make([]catalogEntryModel, 0, len(catalog))
+	for _, entry := range catalog {
+		entries = append(entries, d.entry(entry))
+	}
+
+	filter := catalogFilter{
+		defaultOnly: defaultOnly.ValueBool(),
+		major:       major.ValueInt64Pointer(),
+		minVCPUs:    minVCPUs.ValueInt64Pointer(),
+		minRAMGB:    minRAMGB.ValueFloat64Pointer(),
+		idRegex:     idPattern,
+	}
+
+	compare := strings.Compare
+	if d.versioned {
+		compare = compareVersions
+	}
+
+	state := filterCatalog(entries, filter, compare)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("default"), catalogDefault(entries))...)
+
+	if d.sized {
+		sized := make([]sizedCatalogEntryModel, 0, len(state))
+		for _, entry := range state {
+			sized = append(sized, newSizedCatalogEntryModel(entry))
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.attribute), sized)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("smallest_matching"), smallestCatalogEntry(state))...)
+	} else {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.attribute), state)...)
+	}
+
+	if d.versioned {
+		latest := types.StringNull()
+		if len(state) > 0 {
+			latest = state[len(state)-1].ID
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("latest"), latest)...)
+	}
+}
+
+// catalogFilter selects entries of a catalog. Unset fields match all entries.
+type catalogFilter struct {
+	idRegex     *regexp.Regexp
+	defaultOnly bool
+	major       *int64
+	minVCPUs    *int64
+	minRAMGB    *float64
+}
+
+func (f catalogFilter) matches(entry catalogEntryModel) bool {
+	if f.defaultOnly && !entry.Default.ValueBool() {
+		return false
+	}
+	if f.idRegex != nil && !f.idRegex.MatchString(entry.ID.ValueString()) {
+		return false
+	}
+	if f.major != nil {
+		major, _, _ := strings.Cut(entry.ID.ValueString(), ".")
+		if major != strconv.FormatInt(*f.major, 10) {
+			return false
+		}
+	}
+	if f.minVCPUs != nil || f.minRAMGB != nil {
+		// Entries of unknown size cannot be shown to be large enough.
+		capacity, ok := parseFlavorName(entry.ID.ValueString())
+		if !ok {
+			return false
+		}
+		if f.minVCPUs != nil && capacity.vcpus < *f.minVCPUs {
+			return false
+		}
+		if f.minRAMGB != nil && capacity.ramGB < *f.minRAMGB {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterCatalog returns the entries matching filter, ordered by compare on
+// their ids.
+func filterCatalog(entries []catalogEntryModel, filter catalogFilter, compare func(a, b string) int) []catalogEntryModel {
+	filtered := slices.DeleteFunc(slices.Clone(entries), func(entry catalogEntryModel) bool {
+		return !filter.matches(entry)
+	})
+
+	slices.SortStableFunc(filtered, func(a, b catalogEntryModel) int {
+		return compare(a.ID.ValueString(), b.ID.ValueString())
+	})
+
+	return filtered
+}
+
+// compareVersions compares dotted versions like 16.8 and 17.10 by their
+// numeric components. Components that are not numbers are compared as text.
+func compareVersions(a, b string) int {
+	left, right := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range min(len(left), len(right)) {
+		l, lErr := strconv.Atoi(left[i])
+		r, rErr := strconv.Atoi(right[i])
+		if lErr != nil || rErr != nil {
+			if c := strings.Compare(left[i], right[i]); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := cmp.Compare(l, r); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(left), len(right))
+}
+
+// smallestCatalogEntry returns the id of the entry with the smallest capacity,
+// or null if no entry has a name following the SCS flavor naming standard.
+// Of entries with the same capacity, the first one is returned.
+func smallestCatalogEntry(entries []catalogEntryModel) types.String {
+	smallest := types.StringNull()
+	var smallestCapacity flavorCapacity
+	for _, entry := range entries {
+		capacity, ok := parseFlavorName(entry.ID.ValueString())
+		if !ok {
+			continue
+		}
+		if smallest.IsNull() || compareCapacity(capacity, smallestCapacity) < 0 {
+			smallest, smallestCapacity = entry.ID, capacity
+		}
+	}
+
+	return smallest
+}
+
+// catalogDefault returns the id of the default entry, or null if no entry is
+// the default.
+func catalogDefault(entries []catalogEntryModel) types.String {
+	for _, entry := range entries {
+		if entry.Default.ValueBool() {
+			return entry.ID
+		}
+	}
+
+	return types.StringNull()
+}
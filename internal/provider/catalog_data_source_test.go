@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+func testCatalogEntries(ids ...string) []catalogEntryModel {
+	entries := make([]catalogEntryModel, 0, len(ids))
+	for i, id := range ids {
+		entries = append(entries, catalogEntryModel{
+			ID:          types.StringValue(id),
+			Description: types.StringValue(""),
+			Default:     types.BoolValue(i == 0),
+		})
+	}
+
+	return entries
+}
+
+func TestFilterCatalog(t *testing.T) {
+	// The first entry is the default.
+	entries := testCatalogEntries("dus2", "ams1", "cgn1", "dus1")
+
+	tests := map[string]struct {
+		filter catalogFilter
+		want   []string
+	}{
+		"ordered by id": {want: []string{"ams1", "cgn1", "dus1", "dus2"}},
+		"id_regex":      {filter: catalogFilter{idRegex: regexp.MustCompile(`^dus`)}, want: []string{"dus1", "dus2"}},
+		"default_only":  {filter: catalogFilter{defaultOnly: true}, want: []string{"dus2"}},
+		"no match":      {filter: catalogFilter{idRegex: regexp.MustCompile(`^fra`)}, want: []string{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := filterCatalog(entries, tt.filter, strings.Compare)
+			ids := make([]string, 0, len(got))
+			for _, entry := range got {
+				ids = append(ids, entry.ID.ValueString())
+			}
+			if len(ids) != len(tt.want) {
+				t.Fatalf("filterCatalog() = %v, want %v", ids, tt.want)
+			}
+			for i := range ids {
+				if ids[i] != tt.want[i] {
+					t.Fatalf("filterCatalog() = %v, want %v", ids, tt.want)
+				}
+			}
+		})
+	}
+
+	if entries[0].ID.ValueString() != "dus2" {
+		t.Error("filterCatalog must not reorder the given entries")
+	}
+}
+
+func TestFilterCatalogVersions(t *testing.T) {
+	entries := testCatalogEntries("17.4", "9.6", "17.10", "16.8", "17.2")
+	seventeen := int64(17)
+
+	got := filterCatalog(entries, catalogFilter{major: &seventeen}, compareVersions)
+	want := []string{"17.2", "17.4", "17.10"}
+	if len(got) != len(want) {
+		t.Fatalf("filterCatalog() returned %d entries, want %v", len(got), want)
+	}
+	for i, entry := range got {
+		if entry.ID.ValueString() != want[i] {
+			t.Errorf("entry %d = %s, want %s", i, entry.ID, want[i])
+		}
+	}
+}
+
+func TestFilterCatalogCapacity(t *testing.T) {
+	entries := testCatalogEntries("SCS-2V-4-50n", "SCS-8V-32-50n", "SCS-4V-16-50n", "SCS-4V-8-50n", "m1.large")
+	minVCPUs, minRAMGB := int64(4), float64(16)
+
+	got := filterCatalog(entries, catalogFilter{minVCPUs: &minVCPUs, minRAMGB: &minRAMGB}, strings.Compare)
+	if len(got) != 2 || got[0].ID.ValueString() != "SCS-4V-16-50n" || got[1].ID.ValueString() != "SCS-8V-32-50n" {
+		t.Errorf("filterCatalog() = %v, want SCS-4V-16-50n and SCS-8V-32-50n", got)
+	}
+
+	if smallest := smallestCatalogEntry(filterCatalog(entries, catalogFilter{minVCPUs: &minVCPUs}, strings.Compare)); smallest.ValueString() != "SCS-4V-8-50n" {
+		t.Errorf("smallestCatalogEntry() = %s, want SCS-4V-8-50n", smallest)
+	}
+	if smallest := smallestCatalogEntry(testCatalogEntries("m1.large")); !smallest.IsNull() {
+		t.Errorf("smallestCatalogEntry() = %s, want null", smallest)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"16.8", "17.4", -1},
+		{"9.6", "16.8", -1},
+		{"17.10", "17.9", 1},
+		{"17.4", "17.4", 0},
+		{"17", "17.1", -1},
+		{"17.4", "17.beta1", -1},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := compareVersions(tt.b, tt.a); got != -tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestCatalogDefault(t *testing.T) {
+	if got := catalogDefault(testCatalogEntries("16.8", "17.4")); got.ValueString() != "16.8" {
+		t.Errorf("catalogDefault() = %s, want 16.8", got)
+	}
+
+	entries := testCatalogEntries("16.8")
+	entries[0].Default = types.BoolValue(false)
+	if got := catalogDefault(entries); !got.IsNull() {
+		t.Errorf("catalogDefault() = %s, want null", got)
+	}
+}
+
+func TestCatalogDataSourceFilters(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + `
+data "sys11dbaas_postgresql_versions" "sixteen" {
+  id_regex = "^16\\."
+}
+
+data "sys11dbaas_postgresql_versions" "latest" {
+  major = 16
+}
+
+data "sys11dbaas_postgresql_flavors" "large" {
+  min_vcpus  = 3
+  min_ram_gb = 8
+}
+
+data "sys11dbaas_postgresql_flavors" "default" {
+  default_only = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.sixteen", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.sixteen", "versions.0.id", "16.8"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.sixteen", "default", "17.4"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.sixteen", "latest", "16.8"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.latest", "versions.#", "1"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_versions.latest", "latest", "16.8"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "flavors.#", "1"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "flavors.0.vcpus", "4"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "flavors.0.ram_gb", "8"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "flavors.0.disk_gb", "50"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "flavors.0.disk_type", "network"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.large", "smallest_matching", "SCS-4V-8-50n"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.default", "flavors.#", "1"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.default", "flavors.0.id", "SCS-2V-4-50n"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_postgresql_flavors.default", "default", "SCS-2V-4-50n"),
+				),
+			},
+			{
+				Config:      fake.ProviderConfig() + `data "sys11dbaas_postgresql_regions" "test" { id_regex = "(" }`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+			// A regular expression known only at apply time is checked on read.
+			{
+				Config: fake.ProviderConfig() + `
+resource "terraform_data" "regex" {
+  input = "("
+}
+
+data "sys11dbaas_postgresql_regions" "test" {
+  id_regex = terraform_data.regex.output
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+		},
+	})
+}
+
+func TestCatalogDataSourceReadInvalidRegex(t *testing.T) {
+	ctx := context.Background()
+	d := NewPostgresqlRegionsDataSource().(*catalogDataSource[database.PostgreSQLRegion])
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	// The config is built as a plan, as tfsdk.Config cannot set attributes.
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	plan.SetAttribute(ctx, path.Root("id_regex"), "(")
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config(plan)}, resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid regular expression" {
+		t.Errorf("got diagnostics %v, want an invalid regular expression", resp.Diagnostics)
+	}
+}
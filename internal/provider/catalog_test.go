@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"dus2", "dus2", 0},
+		{"dus2", "dus3", 1},
+		{"SCS-2V-4-50", "SCS-2V-4-50n", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"SCS-2V-4-50n", "SCS-4V-8-50n", "SCS-8V-16-50n"}
+
+	tests := map[string]struct {
+		value string
+		want  string
+	}{
+		"missing suffix": {value: "SCS-2V-4-50", want: "SCS-2V-4-50n"},
+		"case":           {value: "scs-4v-8-50n", want: "SCS-4V-8-50n"},
+		"typo":           {value: "SCS-8V-61-50n", want: "SCS-8V-16-50n"},
+		"unrelated":      {value: "large", want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := closestMatch(tt.value, candidates); got != tt.want {
+				t.Errorf("closestMatch(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatalogValueDetail(t *testing.T) {
+	detail := catalogValueDetail("version", "17.3", []string{"16.8", "17.4"})
+
+	for _, want := range []string{`"17.3" is not an available version.`, `Did you mean "17.4"?`, "Available values: 16.8, 17.4"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected %q in %q", want, detail)
+		}
+	}
+}
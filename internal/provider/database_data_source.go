@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+// databaseDataSourceModel maps a database read by a data source. It has the
+// attributes of DatabaseModel, without the ones only controlling the
+// resource.
+type databaseDataSourceModel struct {
+	ApplicationConfig types.Object      `tfsdk:"application_config"`
+	CreatedAt         timetypes.RFC3339 `tfsdk:"created_at"`
+	CreatedBy         types.String      `tfsdk:"created_by"`
+	Description       types.String      `tfsdk:"description"`
+	LastModifiedAt    timetypes.RFC3339 `tfsdk:"last_modified_at"`
+	LastModifiedBy    types.String      `tfsdk:"last_modified_by"`
+	Name              types.String      `tfsdk:"name"`
+	ServiceConfig     types.Object      `tfsdk:"service_config"`
+	Status            types.String      `tfsdk:"status"`
+	Phase             types.String      `tfsdk:"phase"`
+	ResourceStatus    types.String      `tfsdk:"resource_status"`
+	Uuid              types.String      `tfsdk:"uuid"`
+	Organization      types.String      `tfsdk:"organization"`
+	Project           types.String      `tfsdk:"project"`
+}
+
+// newDatabaseDataSourceModel converts an API response for a database of the
+// given organization and project like psqlGetResponseToModel does for the
+// resource.
+func newDatabaseDataSourceModel(ctx context.Context, organization, project string, db database.PostgreSQLGetResponse) (databaseDataSourceModel, diag.Diagnostics) {
+	var model DatabaseModel
+	diags := psqlGetResponseToModel(ctx, db, &model)
+	applicationConfig, d := withoutAttributes(ctx, model.ApplicationConfig, passwordAttributes...)
+	diags.Append(d...)
+
+	return databaseDataSourceModel{
+		ApplicationConfig: applicationConfig,
+		CreatedAt:         model.CreatedAt,
+		CreatedBy:         model.CreatedBy,
+		Description:       model.Description,
+		LastModifiedAt:    model.LastModifiedAt,
+		LastModifiedBy:    model.LastModifiedBy,
+		Name:              model.Name,
+		ServiceConfig:     model.ServiceConfig,
+		Status:            model.Status,
+		Phase:             model.Phase,
+		ResourceStatus:    model.ResourceStatus,
+		Uuid:              model.Uuid,
+		Organization:      types.StringValue(organization),
+		Project:           types.StringValue(project),
+	}, diags
+}
+
+// databaseDataSourceAttributes returns the attributes of a database in a data
+// source, derived from the resource schema so both describe databases alike.
+func databaseDataSourceAttributes(ctx context.Context) (map[string]schema.Attribute, diag.Diagnostics) {
+	attributes := schemaV0(ctx).Attributes
+	delete(attributes, "wait_for_ready")
+	for _, name := range passwordAttributes {
+		delete(attributes["application_config"].(resourceschema.SingleNestedAttribute).Attributes, name)
+	}
+
+	return computedAttributes(attributes)
+}
+
+// withoutAttributes returns object without the given attributes.
+func withoutAttributes(ctx context.Context, object types.Object, names ...string) (types.Object, diag.Diagnostics) {
+	attributeTypes := maps.Clone(object.AttributeTypes(ctx))
+	for _, name := range names {
+		delete(attributeTypes, name)
+	}
+	if object.IsNull() {
+		return types.ObjectNull(attributeTypes), nil
+	}
+	if object.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), nil
+	}
+
+	attributes := maps.Clone(object.Attributes())
+	for _, name := range names {
+		delete(attributes, name)
+	}
+
+	return types.ObjectValue(attributeTypes, attributes)
+}
+
+// computedAttributes converts attributes of a resource schema into computed
+// data source attributes with the same types and descriptions.
+func computedAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	computed := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		switch a := attribute.(type) {
+		case resourceschema.StringAttribute:
+			computed[name] = schema.StringAttribute{
+				CustomType:  a.CustomType,
+				Computed:    true,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+			}
+		case resourceschema.BoolAttribute:
+			computed[name] = schema.BoolAttribute{Computed: true, Sensitive: a.Sensitive, Description: a.Description}
+		case resourceschema.Int64Attribute:
+			computed[name] = schema.Int64Attribute{Computed: true, Sensitive: a.Sensitive, Description: a.Description}
+		case resourceschema.Float64Attribute:
+			computed[name] = schema.Float64Attribute{Computed: true, Sensitive: a.Sensitive, Description: a.Description}
+		case resourceschema.ListAttribute:
+			computed[name] = schema.ListAttribute{ElementType: a.ElementType, Computed: true, Sensitive: a.Sensitive, Description: a.Description}
+		case resourceschema.MapAttribute:
+			computed[name] = schema.MapAttribute{ElementType: a.ElementType, Computed: true, Sensitive: a.Sensitive, Description: a.Description}
+		case resourceschema.SingleNestedAttribute:
+			nested, d := computedAttributes(a.Attributes)
+			diags.Append(d...)
+			computed[name] = schema.SingleNestedAttribute{
+				Attributes:  nested,
+				Computed:    true,
+				Sensitive:   a.Sensitive,
+				Description: a.Description,
+			}
+		default:
+			diags.AddError(
+				"Unsupported data source attribute",
+				fmt.Sprintf("The resource attribute %s of type %T has no data source counterpart. Please report this issue to the provider developers.", name, attribute),
+			)
+		}
+	}
+
+	return computed, diags
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &DatabaseDataSource{}
+	_ datasource.DataSourceWithConfigure        = &DatabaseDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &DatabaseDataSource{}
+)
+
+// NewDatabaseDataSource is a helper function to simplify the provider implementation.
+func NewDatabaseDataSource() datasource.DataSource {
+	return &DatabaseDataSource{}
+}
+
+// DatabaseDataSource is the data source implementation.
+type DatabaseDataSource struct {
+	client       *database.TypedClient
+	project      types.String
+	organization types.String
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*sys11DBaaSProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sys11DBaaSProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client.V2()
+	d.organization = providerData.organization
+	d.project = providerData.project
+}
+
+// Metadata returns the data source type name.
+func (d *DatabaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabaseDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes, diags := databaseDataSourceAttributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	attributes["uuid"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "UUID of the database. Either uuid or name must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the database. Either uuid or name must be set, the name must be unique within the project.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a database of the configured project by its uuid or name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *DatabaseDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uuid"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config databaseDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var db database.PostgreSQLGetResponse
+	if !config.Uuid.IsNull() {
+		var err error
+		db, err = d.client.GetPostgreSQL(ctx, d.organization.ValueString(), d.project.ValueString(), config.Uuid.ValueString())
+		if isNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("uuid"),
+				"Database not found",
+				fmt.Sprintf("There is no database with uuid %q in project %s.", config.Uuid.ValueString(), d.project.ValueString()),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Database",
+				"Could not read database "+config.Uuid.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		var diags diag.Diagnostics
+		db, diags = d.findByName(ctx, config.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state, diags := newDatabaseDataSourceModel(ctx, d.organization.ValueString(), d.project.ValueString(), db)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// findByName returns the only database of the project called name.
+func (d *DatabaseDataSource) findByName(ctx context.Context, name string) (database.PostgreSQLGetResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	db, err := findDatabaseByName(ctx, d.client, d.organization.ValueString(), d.project.ValueString(), name)
+	if err != nil {
+		diags.Append(diag.WithPath(path.Root("name"), err))
+	}
+
+	return db, diags
+}
+
+// findDatabaseByName returns the only database of the project called name.
+// It returns an error diagnostic if listing the databases fails or if there is
+// not exactly one database with that name.
+func findDatabaseByName(ctx context.Context, client *database.TypedClient, organization, project, name string) (database.PostgreSQLGetResponse, diag.Diagnostic) {
+	databases, err := client.ListPostgreSQL(ctx, organization, project)
+	if err != nil {
+		return database.PostgreSQLGetResponse{}, diag.NewErrorDiagnostic(
+			"Error Reading Databases",
+			"Could not list databases, unexpected error: "+err.Error(),
+		)
+	}
+
+	var matches []database.PostgreSQLGetResponse
+	var uuids []string
+	for _, db := range databases {
+		if db.Name == name {
+			matches = append(matches, db)
+			uuids = append(uuids, db.Uuid)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return database.PostgreSQLGetResponse{}, diag.NewErrorDiagnostic(
+			"Database not found",
+			fmt.Sprintf("There is no database named %q in project %s.", name, project),
+		)
+	case 1:
+		return matches[0], nil
+	default:
+		return database.PostgreSQLGetResponse{}, diag.NewErrorDiagnostic(
+			"Multiple databases found",
+			fmt.Sprintf("There are %d databases named %q in project %s: %s. Use the uuid to select one.", len(matches), name, project, strings.Join(uuids, ", ")),
+		)
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	sys11dbaassdk "github.com/syseleven/sys11dbaas-sdk"
+)
+
+func TestDatabaseDataSource(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	uuid := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "other-team")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+	fake.AddDatabase(testhelpers.FakeOrganization, "other-project", "elsewhere")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+data "sys11dbaas_database" "by_name" {
+  name = "other-team"
+}
+
+data "sys11dbaas_database" "by_uuid" {
+  uuid = %q
+}
+`, uuid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_name", "uuid", uuid),
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_name", "status", "Ready"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_name", "application_config.version", "17.4"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_name", "application_config.public_networking.hostname", uuid+".postgresql.syseleven.services"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_name", "service_config.maintenance_window.start_hour", "2"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_database.by_uuid", "name", "other-team"),
+					resource.TestCheckResourceAttrPair("data.sys11dbaas_database.by_uuid", "created_at", "data.sys11dbaas_database.by_name", "created_at"),
+				),
+			},
+			{
+				Config:      fake.ProviderConfig() + `data "sys11dbaas_database" "test" { name = "duplicate" }`,
+				ExpectError: regexp.MustCompile(`Multiple databases found`),
+			},
+			{
+				Config:      fake.ProviderConfig() + `data "sys11dbaas_database" "test" { name = "elsewhere" }`,
+				ExpectError: regexp.MustCompile(`Database not found`),
+			},
+			{
+				Config:      fake.ProviderConfig() + `data "sys11dbaas_database" "test" { uuid = "00000000-0000-0000-0000-000000000000" }`,
+				ExpectError: regexp.MustCompile(`Database not found`),
+			},
+		},
+	})
+}
+
+func TestDatabaseDataSourceFindByName(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	uuid := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "unique")
+	first := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+	second := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+
+	client, err := sys11dbaassdk.NewClient(fake.URL(), sys11dbaassdk.WithApiKey("fake"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &DatabaseDataSource{
+		client:       client.V2(),
+		organization: types.StringValue(testhelpers.FakeOrganization),
+		project:      types.StringValue(testhelpers.FakeProject),
+	}
+
+	db, diags := d.findByName(context.Background(), "unique")
+	if diags.HasError() || db.Uuid != uuid {
+		t.Errorf("findByName(unique) = %s, %v, want %s", db.Uuid, diags, uuid)
+	}
+
+	_, diags = d.findByName(context.Background(), "duplicate")
+	if !diags.HasError() {
+		t.Fatal("expected an error for a name used twice")
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, first) || !strings.Contains(detail, second) {
+		t.Errorf("expected the error to list both uuids, got %q", detail)
+	}
+}
+
+func TestDatabaseDataSourceAttributes(t *testing.T) {
+	var check func(prefix string, attributes map[string]schema.Attribute)
+	check = func(prefix string, attributes map[string]schema.Attribute) {
+		for name, attribute := range attributes {
+			if !attribute.IsComputed() || attribute.IsOptional() || attribute.IsRequired() {
+				t.Errorf("%s%s is not computed only", prefix, name)
+			}
+			if nested, ok := attribute.(schema.SingleNestedAttribute); ok {
+				check(prefix+name+".", nested.Attributes)
+			}
+		}
+	}
+
+	attributes, diags := databaseDataSourceAttributes(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	check("", attributes)
+
+	if _, ok := attributes["wait_for_ready"]; ok {
+		t.Error("wait_for_ready only controls the resource")
+	}
+	applicationConfig := attributes["application_config"].(schema.SingleNestedAttribute).Attributes
+	for _, name := range passwordAttributes {
+		if _, ok := applicationConfig[name]; ok {
+			t.Errorf("%s is only known to the resource", name)
+		}
+	}
+}
+
+func TestComputedAttributesUnsupported(t *testing.T) {
+	_, diags := computedAttributes(map[string]resourceschema.Attribute{
+		"tags": resourceschema.SetAttribute{ElementType: types.StringType, Optional: true},
+	})
+	if !diags.HasError() {
+		t.Error("expected an error for an unsupported attribute")
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+// databaseListModel maps the list resource schema data.
+type databaseListModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource                   = &DatabaseListResource{}
+	_ list.ListResourceWithConfigure      = &DatabaseListResource{}
+	_ list.ListResourceWithValidateConfig = &DatabaseListResource{}
+)
+
+// NewDatabaseListResource is a helper function to simplify the provider implementation.
+func NewDatabaseListResource() list.ListResource {
+	return &DatabaseListResource{}
+}
+
+// DatabaseListResource lists the databases of the configured project for
+// `terraform query`.
+type DatabaseListResource struct {
+	client       *database.TypedClient
+	project      types.String
+	organization types.String
+}
+
+// Configure adds the provider configured client to the list resource.
+func (l *DatabaseListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*sys11DBaaSProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *sys11DBaaSProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	l.client = providerData.client.V2()
+	l.organization = providerData.organization
+	l.project = providerData.project
+}
+
+// Metadata returns the type name of the listed resource.
+func (l *DatabaseListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+// ListResourceConfigSchema defines the schema of list blocks.
+func (l *DatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the databases of the configured project, ordered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the name of the listed databases must match.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateListResourceConfig checks that name_regex is a valid regular expression.
+func (l *DatabaseListResource) ValidateListResourceConfig(ctx context.Context, req list.ValidateConfigRequest, resp *list.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateRegexAttribute(ctx, req.Config, path.Root("name_regex"))...)
+}
+
+// List emits the identity of each database and, if requested, the resource
+// object it would have after an import.
+func (l *DatabaseListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config databaseListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	organization, project := l.organization.ValueString(), l.project.ValueString()
+	databases, err := l.client.ListPostgreSQL(ctx, organization, project)
+	if err != nil {
+		diags.AddError(
+			"Error Listing Databases",
+			"Could not list databases, unexpected error: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	databases, err = filterDatabases(databases, databasesDataSourceModel{NameRegex: config.NameRegex})
+	if err != nil {
+		diags.Append(invalidNameRegexDiagnostic(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, db := range databases {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = db.Name
+			result.Diagnostics.Append(setIdentity(ctx, result.Identity, organization, project, db.Uuid)...)
+			if req.IncludeResource {
+				model, diags := newListedDatabaseModel(ctx, organization, project, db)
+				result.Diagnostics.Append(diags...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// newListedDatabaseModel converts a listed database into the state an import
+// of it would have.
+func newListedDatabaseModel(ctx context.Context, organization, project string, db database.PostgreSQLGetResponse) (DatabaseModel, diag.Diagnostics) {
+	model := DatabaseModel{
+		Organization: types.StringValue(organization),
+		Project:      types.StringValue(project),
+		WaitForReady: types.BoolNull(),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	diags := psqlGetResponseToModel(ctx, db, &model)
+
+	return model, diags
+}
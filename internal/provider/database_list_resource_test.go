@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sys11dbaassdk "github.com/syseleven/sys11dbaas-sdk"
+)
+
+func TestDatabaseListResource(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	teamB := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "team-b-db")
+	teamA := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "team-a-db")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "reporting")
+	fake.AddDatabase(testhelpers.FakeOrganization, "other-project", "team-c-db")
+
+	client, err := sys11dbaassdk.NewClient(fake.URL(), sys11dbaassdk.WithApiKey("fake"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &DatabaseListResource{
+		client:       client.V2(),
+		organization: types.StringValue(testhelpers.FakeOrganization),
+		project:      types.StringValue(testhelpers.FakeProject),
+	}
+
+	var listSchema list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &listSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	(&DatabaseResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	// nameRegex is nil for an unset filter.
+	newRequest := func(nameRegex any, includeResource bool, limit int64) list.ListRequest {
+		return list.ListRequest{
+			Config: tfsdk.Config{
+				Schema: listSchema.Schema,
+				Raw: tftypes.NewValue(listSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"name_regex": tftypes.NewValue(tftypes.String, nameRegex),
+				}),
+			},
+			IncludeResource:        includeResource,
+			Limit:                  limit,
+			ResourceSchema:         schemaV0(ctx),
+			ResourceIdentitySchema: identitySchema.IdentitySchema,
+		}
+	}
+
+	collect := func(t *testing.T, req list.ListRequest) []list.ListResult {
+		t.Helper()
+		var stream list.ListResultsStream
+		l.List(ctx, req, &stream)
+		results := slices.Collect(stream.Results)
+		for _, result := range results {
+			if result.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+			}
+		}
+		return results
+	}
+
+	t.Run("identities", func(t *testing.T) {
+		results := collect(t, newRequest("^team-", false, 0))
+		if len(results) != 2 {
+			t.Fatalf("got %d results, want 2", len(results))
+		}
+
+		for i, want := range []struct{ name, uuid string }{{"team-a-db", teamA}, {"team-b-db", teamB}} {
+			if results[i].DisplayName != want.name {
+				t.Errorf("result %d is %q, want %q", i, results[i].DisplayName, want.name)
+			}
+			var identity DatabaseIdentityModel
+			results[i].Diagnostics.Append(results[i].Identity.Get(ctx, &identity)...)
+			if identity.Uuid.ValueString() != want.uuid || identity.Project.ValueString() != testhelpers.FakeProject || identity.Organization.ValueString() != testhelpers.FakeOrganization {
+				t.Errorf("result %d has identity %v, want uuid %s", i, identity, want.uuid)
+			}
+			if !results[i].Resource.Raw.IsNull() {
+				t.Errorf("result %d has a resource object, but none was requested", i)
+			}
+		}
+	})
+
+	t.Run("resources", func(t *testing.T) {
+		results := collect(t, newRequest(nil, true, 0))
+		if len(results) != 3 {
+			t.Fatalf("got %d results, want 3", len(results))
+		}
+
+		var model DatabaseModel
+		results[0].Diagnostics.Append(results[0].Resource.Get(ctx, &model)...)
+		if results[0].Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", results[0].Diagnostics)
+		}
+		if model.Name.ValueString() != "reporting" || model.Project.ValueString() != testhelpers.FakeProject {
+			t.Errorf("got database %s in project %s, want reporting in %s", model.Name, model.Project, testhelpers.FakeProject)
+		}
+		if model.ApplicationConfig.IsNull() || model.ServiceConfig.IsNull() {
+			t.Error("expected the application and service config to be mapped")
+		}
+	})
+
+	t.Run("invalid name_regex", func(t *testing.T) {
+		var stream list.ListResultsStream
+		l.List(ctx, newRequest("(", false, 0), &stream)
+		results := slices.Collect(stream.Results)
+		if len(results) != 1 || !results[0].Diagnostics.HasError() {
+			t.Fatalf("got %d results, want a single error", len(results))
+		}
+		if summary := results[0].Diagnostics.Errors()[0].Summary(); summary != "Invalid regular expression" {
+			t.Errorf("got error %q, want an invalid regular expression", summary)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		if results := collect(t, newRequest(nil, false, 1)); len(results) != 1 {
+			t.Errorf("got %d results, want 1", len(results))
+		}
+	})
+}
@@ -3,13 +3,17 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -33,7 +37,41 @@ import (
 	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
 )
 
-const resourceSynced = "Synced"
+const (
+	resourceSynced = "Synced"
+	stateDeleted   = "Deleted"
+)
+
+var (
+	passwordWOPath        = path.Root("application_config").AtName("password_wo")
+	passwordPolicyPath    = path.Root("application_config").AtName("password_policy")
+	passwordRotatedAtPath = path.Root("application_config").AtName("password_last_rotated_at")
+)
+
+const (
+	defaultCreateTimeout = 60 * time.Minute
+	defaultReadTimeout   = 20 * time.Minute
+	defaultUpdateTimeout = 60 * time.Minute
+	defaultDeleteTimeout = 30 * time.Minute
+)
+
+type PasswordPolicyModel struct {
+	Length  types.Int64 `tfsdk:"length"`
+	Lower   types.Bool  `tfsdk:"lower"`
+	Upper   types.Bool  `tfsdk:"upper"`
+	Numeric types.Bool  `tfsdk:"numeric"`
+	Special types.Bool  `tfsdk:"special"`
+}
+
+func (m PasswordPolicyModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"length":  types.Int64Type,
+		"lower":   types.BoolType,
+		"upper":   types.BoolType,
+		"numeric": types.BoolType,
+		"special": types.BoolType,
+	}
+}
 
 type MaintenanceWindowModel struct {
 	DayOfWeek   types.Int64 `tfsdk:"day_of_week"`
@@ -138,21 +176,34 @@ func (m PrivateNetworkingModel) AttributeTypes() map[string]attr.Type {
 }
 
 type ApplicationConfigModel struct {
-	Instances             types.Int64  `tfsdk:"instances"`
-	Password              types.String `tfsdk:"password"`
-	Recovery              types.Object `tfsdk:"recovery"`
-	ScheduledBackups      types.Object `tfsdk:"scheduled_backups"`
-	PrivateNetworking     types.Object `tfsdk:"private_networking"`
-	PublicNetworking      types.Object `tfsdk:"public_networking"`
-	ApplicationConfigType types.String `tfsdk:"type"`
-	Version               types.String `tfsdk:"version"`
-	Features              types.Map    `tfsdk:"features"`
+	Instances             types.Int64       `tfsdk:"instances"`
+	Password              types.String      `tfsdk:"password"`
+	PasswordWO            types.String      `tfsdk:"password_wo"`
+	PasswordWOVersion     types.Int64       `tfsdk:"password_wo_version"`
+	PasswordPolicy        types.Object      `tfsdk:"password_policy"`
+	PasswordTrigger       types.String      `tfsdk:"password_rotation_trigger"`
+	PasswordRotatedAt     timetypes.RFC3339 `tfsdk:"password_last_rotated_at"`
+	Recovery              types.Object      `tfsdk:"recovery"`
+	ScheduledBackups      types.Object      `tfsdk:"scheduled_backups"`
+	PrivateNetworking     types.Object      `tfsdk:"private_networking"`
+	PublicNetworking      types.Object      `tfsdk:"public_networking"`
+	ApplicationConfigType types.String      `tfsdk:"type"`
+	Version               types.String      `tfsdk:"version"`
+	Features              types.Map         `tfsdk:"features"`
+	EffectiveFeatures     types.Map         `tfsdk:"effective_features"`
 }
 
 func (m ApplicationConfigModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"instances": types.Int64Type,
-		"password":  types.StringType,
+		"instances":           types.Int64Type,
+		"password":            types.StringType,
+		"password_wo":         types.StringType,
+		"password_wo_version": types.Int64Type,
+		"password_policy": types.ObjectType{
+			AttrTypes: PasswordPolicyModel{}.AttributeTypes(),
+		},
+		"password_rotation_trigger": types.StringType,
+		"password_last_rotated_at":  timetypes.RFC3339Type{},
 		"recovery": types.ObjectType{
 			AttrTypes: RecoveryModel{}.AttributeTypes(),
 		},
@@ -170,6 +221,9 @@ func (m ApplicationConfigModel) AttributeTypes() map[string]attr.Type {
 		"features": types.MapType{
 			ElemType: types.StringType,
 		},
+		"effective_features": types.MapType{
+			ElemType: types.StringType,
+		},
 	}
 }
 
@@ -210,15 +264,23 @@ type DatabaseModel struct {
 	Phase             types.String      `tfsdk:"phase"`
 	ResourceStatus    types.String      `tfsdk:"resource_status"`
 	Uuid              types.String      `tfsdk:"uuid"`
+	Organization      types.String      `tfsdk:"organization"`
+	Project           types.String      `tfsdk:"project"`
+	WaitForReady      types.Bool        `tfsdk:"wait_for_ready"`
+	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // resource
 
 type DatabaseResource struct {
-	client          *database.TypedClient
-	project         types.String
-	organization    types.String
-	waitForCreation types.Bool
+	client             *database.TypedClient
+	project            types.String
+	organization       types.String
+	waitForCreation    types.Bool
+	waitForDeletion    types.Bool
+	waitForReadyOnRead types.Bool
+	polling            pollingConfig
+	catalogs           *catalogCache
 }
 
 func NewDatabaseResource() resource.Resource {
@@ -250,6 +312,10 @@ func (r *DatabaseResource) Configure(_ context.Context, req resource.ConfigureRe
 	r.organization = providerData.organization
 	r.project = providerData.project
 	r.waitForCreation = providerData.waitForCreation
+	r.waitForDeletion = providerData.waitForDeletion
+	r.waitForReadyOnRead = providerData.waitForReadyOnRead
+	r.polling = providerData.polling
+	r.catalogs = providerData.catalogs
 }
 
 func (r DatabaseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -267,6 +333,237 @@ func (r DatabaseResource) ConfigValidators(ctx context.Context) []resource.Confi
 	}
 }
 
+// ModifyPlan keeps effective_features, drops the legacy password once
+// password_wo is configured and plans rotated and generated passwords along
+// with password_last_rotated_at. It then validates region, flavor, version and
+// feature names against the catalogs of the API, so typos fail the plan
+// instead of the apply. Values that are unknown or unchanged since the last
+// apply are not validated.
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planEffectiveFeatures(ctx, req, resp)
+	r.planPassword(ctx, req, resp)
+	r.planPasswordRotation(ctx, req, resp)
+	if r.catalogs == nil {
+		return
+	}
+
+	checks := []struct {
+		path  path.Path
+		kind  string
+		fetch func(ctx context.Context) ([]string, error)
+	}{
+		{
+			path: path.Root("service_config").AtName("region"),
+			kind: "region",
+			fetch: func(ctx context.Context) ([]string, error) {
+				regions, err := r.catalogs.PostgreSQLRegions(ctx)
+				return catalogIDs(regions, func(region database.PostgreSQLRegion) string { return region.Id }), err
+			},
+		},
+		{
+			path: path.Root("service_config").AtName("flavor"),
+			kind: "flavor",
+			fetch: func(ctx context.Context) ([]string, error) {
+				flavors, err := r.catalogs.PostgreSQLFlavors(ctx)
+				return catalogIDs(flavors, func(flavor database.PostgreSQLFlavor) string { return flavor.Id }), err
+			},
+		},
+		{
+			path: path.Root("application_config").AtName("version"),
+			kind: "version",
+			fetch: func(ctx context.Context) ([]string, error) {
+				versions, err := r.catalogs.PostgreSQLVersions(ctx)
+				return catalogIDs(versions, func(version database.PostgreSQLVersion) string { return version.Id }), err
+			},
+		},
+	}
+
+	for _, check := range checks {
+		var planned types.String
+		diags := req.Plan.GetAttribute(ctx, check.path, &planned)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+			continue
+		}
+
+		if !req.State.Raw.IsNull() {
+			var prior types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, check.path, &prior)...)
+			if prior.Equal(planned) {
+				continue
+			}
+		}
+
+		valid, err := check.fetch(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				check.path,
+				"Unable to validate "+check.kind,
+				"Could not list the available values, unexpected error: "+err.Error(),
+			)
+			continue
+		}
+
+		if !slices.Contains(valid, planned.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				check.path,
+				"Invalid "+check.kind,
+				catalogValueDetail(check.kind, planned.ValueString(), valid),
+			)
+		}
+	}
+
+	r.validateFeatures(ctx, req, resp)
+}
+
+// validateFeatures checks the names of features that were added since the
+// last apply against the features offered by the API.
+func (r *DatabaseResource) validateFeatures(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	featuresPath := path.Root("application_config").AtName("features")
+
+	var planned types.Map
+	diags := req.Plan.GetAttribute(ctx, featuresPath, &planned)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	prior := types.MapNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, featuresPath, &prior)...)
+	}
+
+	var added []string
+	for key := range planned.Elements() {
+		if _, ok := prior.Elements()[key]; !ok {
+			added = append(added, key)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+	slices.Sort(added)
+
+	features, err := r.catalogs.Features(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			featuresPath,
+			"Unable to validate features",
+			"Could not list the available features, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	valid := catalogIDs(features, func(feature database.Feature) string { return feature.Id })
+	for _, key := range added {
+		if !slices.Contains(valid, key) {
+			resp.Diagnostics.AddAttributeError(
+				featuresPath.AtMapKey(key),
+				"Invalid feature",
+				catalogValueDetail("feature", key, valid),
+			)
+		}
+	}
+}
+
+// planEffectiveFeatures keeps effective_features from state as long as the
+// configured features do not change, so an update of an unrelated attribute
+// does not show them as known after apply.
+func (r *DatabaseResource) planEffectiveFeatures(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	featuresPath := path.Root("application_config").AtName("features")
+	effectiveFeaturesPath := path.Root("application_config").AtName("effective_features")
+
+	var planned, prior, priorEffective types.Map
+	diags := req.Plan.GetAttribute(ctx, featuresPath, &planned)
+	diags.Append(req.State.GetAttribute(ctx, featuresPath, &prior)...)
+	diags.Append(req.State.GetAttribute(ctx, effectiveFeaturesPath, &priorEffective)...)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || !planned.Equal(prior) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveFeaturesPath, priorEffective)...)
+}
+
+// planPassword drops the legacy password from the plan once password_wo is
+// configured, so switching to the write-only password removes it from state.
+// It also checks that the policy for a generated password allows any
+// characters.
+func (r *DatabaseResource) planPassword(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var passwordPolicy types.Object
+	diags := req.Plan.GetAttribute(ctx, passwordPolicyPath, &passwordPolicy)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	if !passwordPolicy.IsNull() && !passwordPolicy.IsUnknown() {
+		var policy PasswordPolicyModel
+		resp.Diagnostics.Append(passwordPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+		if !resp.Diagnostics.HasError() && len(newPasswordPolicy(policy).classes()) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				passwordPolicyPath,
+				"Invalid password policy",
+				"At least one of lower, upper, numeric or special must be enabled.",
+			)
+		}
+	}
+
+	var passwordWO types.String
+	diags = req.Config.GetAttribute(ctx, passwordWOPath, &passwordWO)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || passwordWO.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("application_config").AtName("password"), types.StringNull())...)
+}
+
+// writeOnlyPasswordChanged reports whether a configured password_wo has to be
+// sent, because its version changed or it replaces the legacy password.
+func writeOnlyPasswordChanged(plan, state ApplicationConfigModel) bool {
+	return !state.Password.IsNull() || state.PasswordWOVersion.IsNull() || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)
+}
+
+// planPasswordRotation marks password_last_rotated_at as unknown whenever an
+// update sends a new password, and the password itself when a generated one
+// is rotated.
+func (r *DatabaseResource) planPasswordRotation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state ApplicationConfigModel
+	var password, passwordWO types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("application_config"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("application_config"), &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("application_config").AtName("password"), &password)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, passwordWOPath, &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := !plan.PasswordTrigger.Equal(state.PasswordTrigger)
+	if rotate && password.IsNull() && passwordWO.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("application_config").AtName("password"), types.StringUnknown())...)
+		plan.Password = types.StringUnknown()
+	}
+
+	changed := rotate ||
+		(!passwordWO.IsNull() && writeOnlyPasswordChanged(plan, state)) ||
+		(!plan.Password.IsNull() && !plan.Password.Equal(state.Password))
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, passwordRotatedAtPath, timetypes.NewRFC3339Unknown())...)
+	}
+}
+
 // Read resource information.
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
@@ -277,26 +574,52 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	var err error
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	organization, project := r.location(state)
+
 	var response database.PostgreSQLGetResponse
-	for {
-		response, err = r.client.GetPostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), state.Uuid.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error reading database",
-				"Could not read database, unexpected error: "+err.Error(),
-			)
-			return
-		}
-		if response.Status == database.StateReady && response.ResourceStatus == resourceSynced {
-			break
-		}
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			time.Sleep(30 * time.Second)
-		}
+	var err error
+	if r.waitForReadyOnRead.ValueBool() {
+		response, err = r.waitForReady(ctx, organization, project, state.Uuid.ValueString())
+	} else {
+		response, err = r.client.GetPostgreSQL(ctx, organization, project, state.Uuid.ValueString())
+	}
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Database not found, removing it from state", map[string]any{"uuid": state.Uuid.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Timeout reading database",
+			waitTimeoutDetail("become ready", readTimeout, response),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading database",
+			"Could not read database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !isReady(response) {
+		resp.Diagnostics.AddWarning(
+			"Database is not ready",
+			fmt.Sprintf(
+				"The database %s is not ready, values may change once it is. Status: %q, phase: %q, resource_status: %q.",
+				response.Uuid, response.Status, response.Phase, response.ResourceStatus,
+			),
+		)
 	}
 
 	diags = psqlGetResponseToModel(ctx, response, &state)
@@ -304,10 +627,13 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.Organization = types.StringValue(organization)
+	state.Project = types.StringValue(project)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, organization, project, state.Uuid.ValueString())...)
 }
 
 // Create resource.
@@ -320,6 +646,15 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var applicationConfig ApplicationConfigModel
 	diags = plan.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})
 	resp.Diagnostics.Append(diags...)
@@ -453,6 +788,31 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		}
 	}
 
+	// The write-only password is only part of the config. Without any
+	// password, one is generated and kept in state.
+	password := applicationConfig.Password.ValueString()
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, passwordWOPath, &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !passwordWO.IsNull() {
+		password = passwordWO.ValueString()
+	} else if applicationConfig.Password.IsUnknown() || applicationConfig.Password.IsNull() {
+		password, diags = generateApplicationPassword(ctx, applicationConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		applicationConfig.Password = types.StringValue(password)
+	}
+	applicationConfig.PasswordRotatedAt = timetypes.NewRFC3339TimeValue(time.Now().UTC().Truncate(time.Second))
+	plan.ApplicationConfig, diags = types.ObjectValueFrom(ctx, applicationConfig.AttributeTypes(), applicationConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := database.PostgreSQLCreateRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueStringPointer(),
@@ -465,7 +825,7 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		},
 		ApplicationConfig: database.PostgreSQLApplicationConfig{
 			Type:              applicationConfig.ApplicationConfigType.ValueString(),
-			Password:          applicationConfig.Password.ValueString(),
+			Password:          password,
 			Instances:         applicationConfig.Instances.ValueInt64Pointer(),
 			Version:           applicationConfig.Version.ValueString(),
 			ScheduledBackups:  scheduledBackups,
@@ -476,11 +836,13 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		},
 	}
 
-	d, _ := json.Marshal(createRequest)
-	tflog.Debug(ctx, string(d), nil)
+	tflog.Debug(ctx, redactedRequest(createRequest), nil)
 
 	// Create new db
-	createResponse, err := r.client.CreatePostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), createRequest)
+	organization, project := r.location(plan)
+	plan.Organization = types.StringValue(organization)
+	plan.Project = types.StringValue(project)
+	createResponse, err := r.client.CreatePostgreSQL(ctx, organization, project, createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating database",
@@ -489,37 +851,36 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	var response database.PostgreSQLGetResponse
-	if r.waitForCreation.ValueBool() {
-		for {
-			response, err = r.client.GetPostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), createResponse.Uuid)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error waiting for created database",
-					"Could not create database, unexpected error: "+err.Error(),
-				)
-				return
-			}
-			if response.Status == database.StateReady && response.ResourceStatus == resourceSynced {
-				break
-			}
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				time.Sleep(30 * time.Second)
-			}
-		}
+	waitForReady := r.waitForCreation.ValueBool()
+	if !plan.WaitForReady.IsNull() {
+		waitForReady = plan.WaitForReady.ValueBool()
 	}
 
-	diags = psqlGetResponseToModel(ctx, response, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	response := database.PostgreSQLGetResponse(createResponse)
+	if waitForReady {
+		var current database.PostgreSQLGetResponse
+		current, err = r.waitForReady(ctx, organization, project, createResponse.Uuid)
+		if current.Uuid != "" {
+			response = current
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			resp.Diagnostics.AddError(
+				"Timeout waiting for created database",
+				waitTimeoutDetail("become ready", createTimeout, response),
+			)
+		} else if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for created database",
+				"Could not create database, unexpected error: "+err.Error(),
+			)
+		}
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	// The database exists from here on. Keep it in state even if waiting for
+	// it failed, so it is tainted instead of orphaned.
+	resp.Diagnostics.Append(psqlGetResponseToModel(ctx, response, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, organization, project, plan.Uuid.ValueString())...)
 }
 
 // Delete resource.
@@ -532,7 +893,20 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.client.DeletePostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), state.Uuid.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	organization, project := r.location(state)
+	_, err := r.client.DeletePostgreSQL(ctx, organization, project, state.Uuid.ValueString())
+	if isNotFound(err) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete Database",
@@ -540,6 +914,26 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		)
 		return
 	}
+
+	if !r.waitForDeletion.ValueBool() {
+		return
+	}
+
+	response, err := r.waitUntilDeleted(ctx, organization, project, state.Uuid.ValueString())
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Timeout waiting for database deletion",
+			waitTimeoutDetail("finish deleting", deleteTimeout, response),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for database deletion",
+			"The deletion was requested, but it could not be confirmed, unexpected error: "+err.Error(),
+		)
+		return
+	}
 }
 
 // Update resource.
@@ -552,6 +946,15 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var applicationConfig ApplicationConfigModel
 	diags = plan.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})
 	resp.Diagnostics.Append(diags...)
@@ -559,14 +962,163 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	var privateNetworking *database.PostgreSQLPrivateNetworking
-	if !applicationConfig.PrivateNetworking.IsUnknown() {
-		var privateNetworkingModel PrivateNetworkingModel
-		diags = applicationConfig.PrivateNetworking.As(ctx, &privateNetworkingModel, basetypes.ObjectAsOptions{})
+	var state DatabaseModel
+	var priorApplicationConfig ApplicationConfigModel
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, passwordWOPath, &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(state.ApplicationConfig.As(ctx, &priorApplicationConfig, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The write-only password is only sent when its version changes, it
+	// replaces the legacy password or the password is rotated, an empty
+	// password leaves the current one unchanged. Rotating a generated
+	// password generates a new one.
+	rotate := !applicationConfig.PasswordTrigger.Equal(priorApplicationConfig.PasswordTrigger)
+	password := applicationConfig.Password.ValueString()
+	var passwordChanged bool
+	switch {
+	case !passwordWO.IsNull():
+		if rotate || writeOnlyPasswordChanged(applicationConfig, priorApplicationConfig) {
+			password = passwordWO.ValueString()
+			passwordChanged = true
+		}
+	case rotate && applicationConfig.Password.IsUnknown():
+		password, diags = generateApplicationPassword(ctx, applicationConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		applicationConfig.Password = types.StringValue(password)
+		passwordChanged = true
+	default:
+		passwordChanged = password != "" && (rotate || !applicationConfig.Password.Equal(priorApplicationConfig.Password))
+	}
+	if passwordChanged {
+		applicationConfig.PasswordRotatedAt = timetypes.NewRFC3339TimeValue(time.Now().UTC().Truncate(time.Second))
+		plan.ApplicationConfig, diags = types.ObjectValueFrom(ctx, applicationConfig.AttributeTypes(), applicationConfig)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updateRequest, diags := newUpdateRequest(ctx, plan, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, project := r.location(plan)
+	plan.Organization = types.StringValue(organization)
+	plan.Project = types.StringValue(project)
+
+	// The API has no request changing only the password, so a new password
+	// without any other change is sent along with the configuration in state,
+	// so that nothing else is changed.
+	rotationOnly := false
+	if passwordChanged {
+		rotationOnly, diags = onlyPasswordChanged(ctx, plan, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if rotationOnly {
+		updateRequest, diags = newUpdateRequest(ctx, state, password)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
+
+	tflog.Debug(ctx, redactedRequest(updateRequest), nil)
+
+	// Update psql
+	updateResponse, err := r.client.UpdatePostgreSQL(ctx, organization, project, plan.Uuid.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating database",
+			"Could not update database, unexpected error: "+err.Error(),
+		)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// A rotated password always waits until the database has synced it.
+	if !rotationOnly && !plan.WaitForReady.IsNull() && !plan.WaitForReady.ValueBool() {
+		diags = psqlGetResponseToModel(ctx, database.PostgreSQLGetResponse(updateResponse), &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, organization, project, plan.Uuid.ValueString())...)
+		return
+	}
+
+	response, err := r.waitForReady(ctx, organization, project, plan.Uuid.ValueString())
+	if isNotFound(err) {
+		// Terraform keeps no state for a failed update that returns none, so
+		// the next plan recreates the database.
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError(
+			"Database disappeared during update",
+			fmt.Sprintf("The database %s was deleted outside of Terraform while waiting for the update to finish.", plan.Uuid.ValueString()),
+		)
+		return
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		resp.Diagnostics.AddError(
+			"Timeout waiting for update",
+			waitTimeoutDetail("become ready", updateTimeout, response),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for update",
+			"Could not apply requested changes to database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = psqlGetResponseToModel(ctx, response, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, organization, project, plan.Uuid.ValueString())...)
+}
+
+// newUpdateRequest converts model into a request updating the database. An
+// empty password leaves the current one unchanged.
+func newUpdateRequest(ctx context.Context, model DatabaseModel, password string) (database.PostgreSQLCreateRequest, diag.Diagnostics) {
+	var request database.PostgreSQLCreateRequest
+	var diags diag.Diagnostics
+	var applicationConfig ApplicationConfigModel
+	diags.Append(model.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return request, diags
+	}
+
+	var privateNetworking *database.PostgreSQLPrivateNetworking
+	if !applicationConfig.PrivateNetworking.IsUnknown() {
+		var privateNetworkingModel PrivateNetworkingModel
+		diags.Append(applicationConfig.PrivateNetworking.As(ctx, &privateNetworkingModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
+		}
 
 		privateNetworking = &database.PostgreSQLPrivateNetworking{
 			Enabled:          privateNetworkingModel.Enabled.ValueBoolPointer(),
@@ -579,10 +1131,9 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 
 		if !privateNetworkingModel.AllowedCIDRs.IsNull() && !privateNetworkingModel.AllowedCIDRs.IsUnknown() {
 			var privateAllowedCidrs []string
-			diags = privateNetworkingModel.AllowedCIDRs.ElementsAs(ctx, &privateAllowedCidrs, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
+			diags.Append(privateNetworkingModel.AllowedCIDRs.ElementsAs(ctx, &privateAllowedCidrs, false)...)
+			if diags.HasError() {
+				return request, diags
 			}
 
 			if len(privateAllowedCidrs) > 0 {
@@ -595,10 +1146,9 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	var publicNetworking *database.PostgreSQLPublicNetworking
 	if !applicationConfig.PublicNetworking.IsUnknown() {
 		var publicNetworkingModel PublicNetworkingModel
-		diags = applicationConfig.PublicNetworking.As(ctx, &publicNetworkingModel, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(applicationConfig.PublicNetworking.As(ctx, &publicNetworkingModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
 		}
 
 		publicNetworking = &database.PostgreSQLPublicNetworking{
@@ -609,10 +1159,9 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 
 		if !publicNetworkingModel.AllowedCIDRs.IsNull() && !publicNetworkingModel.AllowedCIDRs.IsUnknown() {
 			var publicAllowedCidrs []string
-			diags = publicNetworkingModel.AllowedCIDRs.ElementsAs(ctx, &publicAllowedCidrs, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
+			diags.Append(publicNetworkingModel.AllowedCIDRs.ElementsAs(ctx, &publicAllowedCidrs, false)...)
+			if diags.HasError() {
+				return request, diags
 			}
 
 			if len(publicAllowedCidrs) > 0 {
@@ -624,15 +1173,15 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	var scheduledBackups *database.PostgreSQLBackupSchedule
 	if !applicationConfig.ScheduledBackups.IsUnknown() {
 		var scheduledBackupsModel ScheduledBackupsModel
-		resp.Diagnostics.Append(applicationConfig.ScheduledBackups.As(ctx, &scheduledBackupsModel, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(applicationConfig.ScheduledBackups.As(ctx, &scheduledBackupsModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
 		}
 
 		var schedule ScheduleModel
-		resp.Diagnostics.Append(scheduledBackupsModel.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(scheduledBackupsModel.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
 		}
 
 		scheduledBackups = &database.PostgreSQLBackupSchedule{
@@ -647,9 +1196,9 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	var recovery *database.PostgreSQLRecovery
 	if !applicationConfig.Recovery.IsUnknown() && !applicationConfig.Recovery.IsNull() {
 		var recoveryModel RecoveryModel
-		resp.Diagnostics.Append(applicationConfig.Recovery.As(ctx, &recoveryModel, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(applicationConfig.Recovery.As(ctx, &recoveryModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
 		}
 
 		recovery = &database.PostgreSQLRecovery{
@@ -663,17 +1212,17 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	var serviceConfig ServiceConfigModel
-	resp.Diagnostics.Append(plan.ServiceConfig.As(ctx, &serviceConfig, basetypes.ObjectAsOptions{})...)
-	if resp.Diagnostics.HasError() {
-		return
+	diags.Append(model.ServiceConfig.As(ctx, &serviceConfig, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return request, diags
 	}
 
 	var maintenanceWindow *database.PostgreSQLMaintenance
 	if !serviceConfig.MaintenanceWindow.IsUnknown() {
 		var maintenanceWindowModel MaintenanceWindowModel
-		resp.Diagnostics.Append(serviceConfig.MaintenanceWindow.As(ctx, &maintenanceWindowModel, basetypes.ObjectAsOptions{})...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(serviceConfig.MaintenanceWindow.As(ctx, &maintenanceWindowModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return request, diags
 		}
 
 		maintenanceWindow = &database.PostgreSQLMaintenance{
@@ -685,15 +1234,15 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 
 	var features *map[string]database.PostgreSQLApplicationConfigFeatures
 	if !applicationConfig.Features.IsUnknown() && !applicationConfig.Features.IsNull() {
-		resp.Diagnostics.Append(applicationConfig.Features.ElementsAs(ctx, &features, false)...)
-		if resp.Diagnostics.HasError() {
-			return
+		diags.Append(applicationConfig.Features.ElementsAs(ctx, &features, false)...)
+		if diags.HasError() {
+			return request, diags
 		}
 	}
 
-	updateRequest := database.PostgreSQLCreateRequest{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueStringPointer(),
+	request = database.PostgreSQLCreateRequest{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueStringPointer(),
 		ServiceConfig: database.PostgreSQLServiceConfig{
 			Disksize:          serviceConfig.Disksize.ValueInt64Pointer(),
 			Type:              serviceConfig.ServiceConfigType.ValueString(),
@@ -703,7 +1252,7 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		},
 		ApplicationConfig: database.PostgreSQLApplicationConfig{
 			Type:              applicationConfig.ApplicationConfigType.ValueString(),
-			Password:          applicationConfig.Password.ValueString(),
+			Password:          password,
 			Instances:         applicationConfig.Instances.ValueInt64Pointer(),
 			Version:           applicationConfig.Version.ValueString(),
 			ScheduledBackups:  scheduledBackups,
@@ -714,53 +1263,114 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		},
 	}
 
-	d, _ := json.Marshal(updateRequest)
-	tflog.Debug(ctx, string(d), nil)
+	return request, diags
+}
 
-	// Update psql
-	_, err := r.client.UpdatePostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), plan.Uuid.ValueString(), updateRequest)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating database",
-			"Could not update database, unexpected error: "+err.Error(),
-		)
-		resp.Diagnostics.Append(diags...)
-		return
+// passwordAttributes are the attributes of the application config which only
+// concern the admin password: the password itself, which the API never
+// returns, and the ones controlling how the resource sets it. The database
+// data source leaves them out.
+var passwordAttributes = []string{
+	"password",
+	"password_wo",
+	"password_wo_version",
+	"password_policy",
+	"password_rotation_trigger",
+	"password_last_rotated_at",
+}
+
+// onlyPasswordChanged reports whether plan differs from state in nothing but
+// the admin password and the attributes controlling it.
+func onlyPasswordChanged(ctx context.Context, plan, state DatabaseModel) (bool, diag.Diagnostics) {
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.ServiceConfig.Equal(state.ServiceConfig) {
+		return false, nil
 	}
 
+	var diags diag.Diagnostics
+	planApplicationConfig, d := withoutAttributes(ctx, plan.ApplicationConfig, passwordAttributes...)
+	diags.Append(d...)
+	stateApplicationConfig, d := withoutAttributes(ctx, state.ApplicationConfig, passwordAttributes...)
+	diags.Append(d...)
+
+	return planApplicationConfig.Equal(stateApplicationConfig), diags
+}
+
+// waitForReady polls the database until it is ready and all changes are synced.
+// It returns the last response received, even if waiting failed.
+func (r *DatabaseResource) waitForReady(ctx context.Context, organization, project, uuid string) (database.PostgreSQLGetResponse, error) {
 	var response database.PostgreSQLGetResponse
-	for {
-		response, err = r.client.GetPostgreSQL(ctx, r.organization.ValueString(), r.project.ValueString(), plan.Uuid.ValueString())
+	err := r.polling.poll(ctx, func(ctx context.Context) (bool, error) {
+		current, err := r.client.GetPostgreSQL(ctx, organization, project, uuid)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error waiting for update",
-				"Could not apply requested changes to database, unexpected error: "+err.Error(),
-			)
-			return
+			return false, err
 		}
-		if response.Status == database.StateReady && response.ResourceStatus == resourceSynced {
-			break
+
+		response = current
+		return isReady(response), nil
+	})
+
+	return response, err
+}
+
+// location returns the organization and project of the database in model.
+// Databases created before they were tracked in state belong to the
+// provider's organization and project.
+func (r *DatabaseResource) location(model DatabaseModel) (organization, project string) {
+	organization, project = r.organization.ValueString(), r.project.ValueString()
+	if !model.Organization.IsNull() && !model.Organization.IsUnknown() {
+		organization = model.Organization.ValueString()
+	}
+	if !model.Project.IsNull() && !model.Project.IsUnknown() {
+		project = model.Project.ValueString()
+	}
+
+	return organization, project
+}
+
+// isReady reports whether the database is ready and all changes are synced.
+func isReady(response database.PostgreSQLGetResponse) bool {
+	return response.Status == database.StateReady && response.ResourceStatus == resourceSynced
+}
+
+// waitUntilDeleted polls the database until the API reports it as deleted or
+// does not know it anymore.
+func (r *DatabaseResource) waitUntilDeleted(ctx context.Context, organization, project, uuid string) (database.PostgreSQLGetResponse, error) {
+	var response database.PostgreSQLGetResponse
+	err := r.polling.poll(ctx, func(ctx context.Context) (bool, error) {
+		current, err := r.client.GetPostgreSQL(ctx, organization, project, uuid)
+		if isNotFound(err) {
+			return true, nil
 		}
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			time.Sleep(30 * time.Second)
+		if err != nil {
+			return false, err
 		}
-	}
 
-	diags = psqlGetResponseToModel(ctx, response, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+		response = current
+		return response.Status == stateDeleted, nil
+	})
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	return response, err
+}
+
+// waitTimeoutDetail describes a timed out wait by the last observed state, so
+// a database that is still busy can be told apart from a stuck one.
+func waitTimeoutDetail(goal string, timeout time.Duration, last database.PostgreSQLGetResponse) string {
+	return fmt.Sprintf(
+		"The database did not %s within %s. Last observed status: %q, phase: %q, resource_status: %q.",
+		goal, timeout, last.Status, last.Phase, last.ResourceStatus,
+	)
 }
 
-func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("uuid"), req, resp)
+// redactedRequest returns request as JSON for debug logs. The admin password
+// is masked, as it must not end up in log files.
+func redactedRequest(request database.PostgreSQLCreateRequest) string {
+	if request.ApplicationConfig.Password != "" {
+		request.ApplicationConfig.Password = "***"
+	}
+
+	d, _ := json.Marshal(request)
+
+	return string(d)
 }
 
 // Schema defines the schema for the resource.
@@ -769,12 +1379,24 @@ func (r *DatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 }
 
 func (r *DatabaseResource) MoveState(ctx context.Context) []resource.StateMover {
-	return []resource.StateMover{}
+	return []resource.StateMover{
+		{
+			StateMover: moveStateFromDatabaseV2,
+		},
+	}
 }
 
 func schemaV0(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Version: 0,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"application_config": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -789,10 +1411,85 @@ func schemaV0(ctx context.Context) schema.Schema {
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
-						Description: "Password for the admin user.",
+						Description: "Password for the admin user. A random one is generated on creation if neither password nor password_wo is set.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(16),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"password_wo": schema.StringAttribute{
+						Optional:    true,
+						WriteOnly:   true,
+						Sensitive:   true,
+						Description: "Write-only password for the admin user, which is not stored in state. Requires password_wo_version. It is sent when the database is created, when it replaces password and whenever password_wo_version or password_rotation_trigger changes. Requires Terraform 1.11 or later.",
 						Validators: []validator.String{
 							stringvalidator.LengthAtLeast(16),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password")),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo_version")),
+						},
+					},
+					"password_wo_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Version of password_wo. Change it to send a new password_wo to the database.",
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+						},
+					},
+					"password_policy": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Policy for the password generated when neither password nor password_wo is set. It is used when the database is created and when the password is rotated.",
+						Attributes: map[string]schema.Attribute{
+							"length": schema.Int64Attribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Length of the generated password.",
+								Default:     int64default.StaticInt64(defaultPasswordLength),
+								Validators: []validator.Int64{
+									int64validator.AtLeast(minPasswordLength),
+								},
+							},
+							"lower": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether the generated password contains lowercase letters.",
+								Default:     booldefault.StaticBool(true),
+							},
+							"upper": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether the generated password contains uppercase letters.",
+								Default:     booldefault.StaticBool(true),
+							},
+							"numeric": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether the generated password contains digits.",
+								Default:     booldefault.StaticBool(true),
+							},
+							"special": schema.BoolAttribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "Whether the generated password contains special characters.",
+								Default:     booldefault.StaticBool(true),
+							},
+						},
+						Validators: []validator.Object{
+							objectvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("password"),
+								path.MatchRelative().AtParent().AtName("password_wo"),
+							),
 						},
+					},
+					"password_rotation_trigger": schema.StringAttribute{
+						Optional:    true,
+						Description: "Arbitrary value which rotates the admin password whenever it changes. A generated password is replaced by a new one, a configured password or password_wo is sent again. As the API has no password-only update, the rotation still sends the full configuration, taken from state unless other attributes change as well.",
+					},
+					"password_last_rotated_at": schema.StringAttribute{
+						CustomType:  timetypes.RFC3339Type{},
+						Computed:    true,
+						Description: "Date when the admin password was last set by Terraform.",
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
@@ -925,6 +1622,11 @@ func schemaV0(ctx context.Context) schema.Schema {
 							mapvalidator.ValueStringsAre(stringvalidator.OneOf("on", "off")),
 						},
 					},
+					"effective_features": schema.MapAttribute{
+						ElementType: types.StringType,
+						Computed:    true,
+						Description: "Features applied to the PostgreSQL database, including the defaults of features not set in 'features'.",
+					},
 					"private_networking": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
@@ -1179,6 +1881,24 @@ func schemaV0(ctx context.Context) schema.Schema {
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization of the database. This is the provider's organization, unless the database was imported from another one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Computed:    true,
+				Description: "Project of the database. This is the provider's project, unless the database was imported from another one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to wait until the database is ready after creating or updating it. Overrides the provider's `wait_for_creation` for creation. Updates wait unless this is set to false.",
+			},
 		},
 	}
 }
@@ -1191,6 +1911,8 @@ func psqlGetResponseToModel(ctx context.Context, db database.PostgreSQLGetRespon
 		ServiceConfigType: types.StringValue(db.ServiceConfig.Type),
 		Flavor:            types.StringValue(db.ServiceConfig.Flavor),
 		Region:            types.StringValue(db.ServiceConfig.Region),
+		MaintenanceWindow: types.ObjectNull(MaintenanceWindowModel{}.AttributeTypes()),
+		RemoteIps:         types.ListNull(types.StringType),
 	}
 
 	if db.ServiceConfig.MaintenanceWindow != nil {
@@ -1204,37 +1926,74 @@ func psqlGetResponseToModel(ctx context.Context, db database.PostgreSQLGetRespon
 		serviceConfig.MaintenanceWindow = objectValue
 	}
 
-	applicationConfig := ApplicationConfigModel{}
+	applicationConfig := ApplicationConfigModel{
+		Instances:             types.Int64PointerValue(db.ApplicationConfig.Instances),
+		ApplicationConfigType: types.StringValue(db.ApplicationConfig.Type),
+		Version:               types.StringValue(db.ApplicationConfig.Version),
+		Password:              types.StringNull(),
+		PasswordWO:            types.StringNull(),
+		PasswordWOVersion:     types.Int64Null(),
+		PasswordPolicy:        types.ObjectNull(PasswordPolicyModel{}.AttributeTypes()),
+		PasswordTrigger:       types.StringNull(),
+		PasswordRotatedAt:     timetypes.NewRFC3339Null(),
+	}
+	if db.ApplicationConfig.Password != "" {
+		applicationConfig.Password = types.StringValue(db.ApplicationConfig.Password)
+	}
+
+	// The API does not return the password, so it is kept from prior state
+	// along with the version of the write-only password, the policy of a
+	// generated one and the rotation settings.
+	// Features are only tracked for the keys that were set before, the
+	// defaults applied by the API end up in effective_features.
+	priorFeatures := types.MapNull(types.StringType)
 	if !model.ApplicationConfig.IsNull() && !model.ApplicationConfig.IsUnknown() {
-		diags.Append(model.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})...)
+		var priorApplicationConfig ApplicationConfigModel
+		diags.Append(model.ApplicationConfig.As(ctx, &priorApplicationConfig, basetypes.ObjectAsOptions{})...)
 		if diags.HasError() {
 			return diags
 		}
-	} else {
-		applicationConfig.Features = types.MapNull(types.StringType)
-		applicationConfig.Instances = types.Int64PointerValue(db.ApplicationConfig.Instances)
-		applicationConfig.ApplicationConfigType = types.StringValue(db.ApplicationConfig.Type)
-		applicationConfig.Version = types.StringValue(db.ApplicationConfig.Version)
+		if !priorApplicationConfig.Password.IsNull() && !priorApplicationConfig.Password.IsUnknown() {
+			applicationConfig.Password = priorApplicationConfig.Password
+		}
+		if !priorApplicationConfig.PasswordWOVersion.IsUnknown() {
+			applicationConfig.PasswordWOVersion = priorApplicationConfig.PasswordWOVersion
+		}
+		if !priorApplicationConfig.PasswordPolicy.IsUnknown() {
+			applicationConfig.PasswordPolicy = priorApplicationConfig.PasswordPolicy
+		}
+		if !priorApplicationConfig.PasswordTrigger.IsUnknown() {
+			applicationConfig.PasswordTrigger = priorApplicationConfig.PasswordTrigger
+		}
+		if !priorApplicationConfig.PasswordRotatedAt.IsUnknown() {
+			applicationConfig.PasswordRotatedAt = priorApplicationConfig.PasswordRotatedAt
+		}
+		if !priorApplicationConfig.Features.IsUnknown() {
+			priorFeatures = priorApplicationConfig.Features
+		}
 	}
 
-	if db.ApplicationConfig.ScheduledBackups != nil && db.ApplicationConfig.ScheduledBackups.Schedule != nil {
+	if db.ApplicationConfig.ScheduledBackups != nil {
 		scheduledBackups := ScheduledBackupsModel{
 			Retention: types.Int64PointerValue(db.ApplicationConfig.ScheduledBackups.Retention),
+			Schedule:  types.ObjectNull(ScheduleModel{}.AttributeTypes()),
 		}
 
-		schedule := ScheduleModel{
-			Hour:   types.Int64PointerValue(db.ApplicationConfig.ScheduledBackups.Schedule.Hour),
-			Minute: types.Int64PointerValue(db.ApplicationConfig.ScheduledBackups.Schedule.Minute),
+		if db.ApplicationConfig.ScheduledBackups.Schedule != nil {
+			schedule := ScheduleModel{
+				Hour:   types.Int64PointerValue(db.ApplicationConfig.ScheduledBackups.Schedule.Hour),
+				Minute: types.Int64PointerValue(db.ApplicationConfig.ScheduledBackups.Schedule.Minute),
+			}
+			objectValue, conversionDiags := types.ObjectValueFrom(ctx, schedule.AttributeTypes(), schedule)
+			diags.Append(conversionDiags...)
+			scheduledBackups.Schedule = objectValue
 		}
-		objectValue, conversionDiags := types.ObjectValueFrom(ctx, schedule.AttributeTypes(), schedule)
-		diags.Append(conversionDiags...)
-		scheduledBackups.Schedule = objectValue
 
-		objectValue, conversionDiags = types.ObjectValueFrom(ctx, scheduledBackups.AttributeTypes(), scheduledBackups)
+		objectValue, conversionDiags := types.ObjectValueFrom(ctx, scheduledBackups.AttributeTypes(), scheduledBackups)
 		diags.Append(conversionDiags...)
 		applicationConfig.ScheduledBackups = objectValue
 	} else {
-		applicationConfig.ScheduledBackups = types.ObjectNull(ScheduleModel{}.AttributeTypes())
+		applicationConfig.ScheduledBackups = types.ObjectNull(ScheduledBackupsModel{}.AttributeTypes())
 	}
 
 	if db.ApplicationConfig.Recovery != nil {
@@ -1320,22 +2079,29 @@ func psqlGetResponseToModel(ctx context.Context, db database.PostgreSQLGetRespon
 			if !planServiceConfig.RemoteIps.IsUnknown() && !planServiceConfig.RemoteIps.IsNull() {
 				tflog.Debug(ctx, "wrapping public allowed cidrs into remote_ips")
 				serviceConfig.RemoteIps = publicAllowedCidrs
-			} else {
-				serviceConfig.RemoteIps = types.ListNull(types.StringType)
 			}
-		} else {
-			serviceConfig.RemoteIps = types.ListNull(types.StringType)
 		}
 	} else {
 		applicationConfig.PublicNetworking = types.ObjectNull(PublicNetworkingModel{}.AttributeTypes())
 	}
 
+	applicationConfig.Features = types.MapNull(types.StringType)
+	applicationConfig.EffectiveFeatures = types.MapNull(types.StringType)
 	if db.ApplicationConfig.Features != nil {
 		var conversionDiags []diag.Diagnostic
-		applicationConfig.Features, conversionDiags = types.MapValueFrom(ctx, types.StringType, *db.ApplicationConfig.Features)
+		applicationConfig.EffectiveFeatures, conversionDiags = types.MapValueFrom(ctx, types.StringType, *db.ApplicationConfig.Features)
 		diags.Append(conversionDiags...)
-	} else {
-		applicationConfig.Features = types.MapNull(types.StringType)
+
+		if !priorFeatures.IsNull() {
+			features := map[string]attr.Value{}
+			for key := range priorFeatures.Elements() {
+				if value, ok := (*db.ApplicationConfig.Features)[key]; ok {
+					features[key] = types.StringValue(string(value))
+				}
+			}
+			applicationConfig.Features, conversionDiags = types.MapValue(types.StringType, features)
+			diags.Append(conversionDiags...)
+		}
 	}
 
 	model.Uuid = types.StringValue(db.Uuid)
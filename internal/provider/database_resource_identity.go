@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &DatabaseResource{}
+
+// DatabaseIdentityModel maps the resource identity schema data.
+type DatabaseIdentityModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Project      types.String `tfsdk:"project"`
+	Uuid         types.String `tfsdk:"uuid"`
+}
+
+// IdentitySchema defines the identity of a database. It does not change over
+// the lifetime of the database.
+func (r *DatabaseResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Organization of the database. Defaults to the provider's organization on import.",
+			},
+			"project": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "Project of the database. Defaults to the provider's project on import.",
+			},
+			"uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the database.",
+			},
+		},
+	}
+}
+
+// setIdentity sets the identity of the database, if Terraform supports
+// resource identities.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, organization, project, uuid string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, DatabaseIdentityModel{
+		Organization: types.StringValue(organization),
+		Project:      types.StringValue(project),
+		Uuid:         types.StringValue(uuid),
+	})
+}
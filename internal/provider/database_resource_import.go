@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// uuidPattern matches database uuids. Import IDs not matching it are taken as
+// database names.
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ImportState imports a database by its identity or by an ID of the form
+// [[organization/]project/]uuid, where the uuid may also be the name of the
+// database. The organization and project default to the ones of the provider.
+func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organization, project := r.organization.ValueString(), r.project.ValueString()
+
+	if req.ID == "" && req.Identity != nil {
+		var identity DatabaseIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !identity.Organization.IsNull() {
+			organization = identity.Organization.ValueString()
+		}
+		if !identity.Project.IsNull() {
+			project = identity.Project.ValueString()
+		}
+
+		r.setImportedState(ctx, resp, organization, project, identity.Uuid.ValueString())
+		return
+	}
+
+	parts := strings.Split(req.ID, "/")
+	switch len(parts) {
+	case 1:
+	case 2:
+		project = parts[0]
+	case 3:
+		organization, project = parts[0], parts[1]
+	}
+	if len(parts) > 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form uuid, name, project/uuid, project/name, organization/project/uuid or organization/project/name, got: %q.", req.ID),
+		)
+		return
+	}
+
+	uuid := parts[len(parts)-1]
+	if !uuidPattern.MatchString(uuid) {
+		db, err := findDatabaseByName(ctx, r.client, organization, project, uuid)
+		if err != nil {
+			resp.Diagnostics.Append(err)
+			return
+		}
+		uuid = db.Uuid
+	}
+
+	r.setImportedState(ctx, resp, organization, project, uuid)
+}
+
+// setImportedState sets the state and identity Read needs to refresh the
+// imported database.
+func (r *DatabaseResource) setImportedState(ctx context.Context, resp *resource.ImportStateResponse, organization, project, uuid string) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), uuid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, organization, project, uuid)...)
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	testresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDatabaseResourceImportByName(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	resourceName := "import_by_name"
+
+	testresource.ParallelTest(t, testresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []testresource.TestStep{
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = %q
+  application_config = {
+    instances = 1
+    password  = "veryS3cretPassword"
+    version   = "17.4"
+    public_networking = {
+      enabled = false
+    }
+  }
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`, resourceName),
+			},
+			{
+				ResourceName:                         "sys11dbaas_database.test",
+				ImportStateId:                        testhelpers.FakeProject + "/" + resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "uuid",
+				ImportStateVerifyIgnore:              []string{"application_config.password"},
+			},
+			{
+				ResourceName: "sys11dbaas_database.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "a/b/c/" + s.RootModule().Resources["sys11dbaas_database.test"].Primary.Attributes["uuid"], nil
+				},
+				ImportState: true,
+				ExpectError: regexp.MustCompile(`Invalid import ID`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceImportState(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	own := fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "own")
+	other := fake.AddDatabase("other-organization", "other-project", "other")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "duplicate")
+	r := newFakeDatabaseResource(t, fake)
+
+	tests := map[string]struct {
+		id           string
+		uuid         string
+		organization string
+		project      string
+		err          string
+	}{
+		"uuid":                      {id: own, uuid: own, organization: testhelpers.FakeOrganization, project: testhelpers.FakeProject},
+		"name":                      {id: "own", uuid: own, organization: testhelpers.FakeOrganization, project: testhelpers.FakeProject},
+		"project and uuid":          {id: testhelpers.FakeProject + "/" + own, uuid: own, organization: testhelpers.FakeOrganization, project: testhelpers.FakeProject},
+		"organization project uuid": {id: "other-organization/other-project/" + other, uuid: other, organization: "other-organization", project: "other-project"},
+		"organization project name": {id: "other-organization/other-project/other", uuid: other, organization: "other-organization", project: "other-project"},
+		"unknown name":              {id: "missing", err: "Database not found"},
+		"name in other project":     {id: "other", err: "Database not found"},
+		"ambiguous name":            {id: "duplicate", err: "Multiple databases found"},
+		"empty segment":             {id: "/" + own, err: "Invalid import ID"},
+		"too many segments":         {id: "a/b/c/" + own, err: "Invalid import ID"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := schemaV0(ctx)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+
+			if tt.err != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), tt.err) {
+					t.Fatalf("expected error %q, got %v", tt.err, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			for attribute, want := range map[string]string{"uuid": tt.uuid, "organization": tt.organization, "project": tt.project} {
+				var got types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(attribute), &got)...)
+				if got.ValueString() != want {
+					t.Errorf("%s = %q, want %q", attribute, got.ValueString(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceIdentity(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	config := fake.ProviderConfig() + `
+resource "sys11dbaas_database" "test" {
+  name = "identity"
+  application_config = {
+    instances = 1
+    password  = "veryS3cretPassword"
+    version   = "17.4"
+    public_networking = {
+      enabled = false
+    }
+  }
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`
+
+	testresource.ParallelTest(t, testresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []testresource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("sys11dbaas_database.test", map[string]knownvalue.Check{
+						"organization": knownvalue.StringExact(testhelpers.FakeOrganization),
+						"project":      knownvalue.StringExact(testhelpers.FakeProject),
+						"uuid":         knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("sys11dbaas_database.test", tfjsonpath.New("uuid")),
+				},
+			},
+			// Refreshing must not change the identity.
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("sys11dbaas_database.test", tfjsonpath.New("uuid")),
+				},
+			},
+			{
+				Config:          config,
+				ResourceName:    "sys11dbaas_database.test",
+				ImportState:     true,
+				ImportStateKind: testresource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	uuid := fake.AddDatabase("other-organization", "other-project", "other")
+	r := newFakeDatabaseResource(t, fake)
+
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+	newIdentity := func() *tfsdk.ResourceIdentity {
+		return &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw:    tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	tests := map[string]struct {
+		identity DatabaseIdentityModel
+		want     DatabaseIdentityModel
+	}{
+		"uuid only": {
+			identity: DatabaseIdentityModel{Organization: types.StringNull(), Project: types.StringNull(), Uuid: types.StringValue(uuid)},
+			want:     DatabaseIdentityModel{Organization: types.StringValue(testhelpers.FakeOrganization), Project: types.StringValue(testhelpers.FakeProject), Uuid: types.StringValue(uuid)},
+		},
+		"other organization": {
+			identity: DatabaseIdentityModel{Organization: types.StringValue("other-organization"), Project: types.StringValue("other-project"), Uuid: types.StringValue(uuid)},
+			want:     DatabaseIdentityModel{Organization: types.StringValue("other-organization"), Project: types.StringValue("other-project"), Uuid: types.StringValue(uuid)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ImportStateRequest{Identity: newIdentity()}
+			if diags := req.Identity.Set(ctx, tt.identity); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			s := schemaV0(ctx)
+			resp := &resource.ImportStateResponse{
+				State:    tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+				Identity: newIdentity(),
+			}
+
+			r.ImportState(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got DatabaseIdentityModel
+			resp.Diagnostics.Append(resp.Identity.Get(ctx, &got)...)
+			if got != tt.want {
+				t.Errorf("identity = %v, want %v", got, tt.want)
+			}
+			var project types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project"), &project)...)
+			if !project.Equal(tt.want.Project) {
+				t.Errorf("project = %v, want %v", project, tt.want.Project)
+			}
+		})
+	}
+}
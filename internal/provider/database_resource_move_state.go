@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfaddr "github.com/hashicorp/terraform-registry-address"
+)
+
+const (
+	databaseV2TypeName          = "sys11dbaas_database_v2"
+	databaseV2ProviderNamespace = "syseleven"
+	databaseV2ProviderType      = "sys11dbaas"
+)
+
+// databaseV2State is the prior state of a sys11dbaas_database_v2 resource.
+// It is decoded from the raw state, as the v2 resource and its schema are
+// not part of this provider anymore.
+type databaseV2State struct {
+	UUID              string                       `json:"uuid"`
+	Name              string                       `json:"name"`
+	Description       *string                      `json:"description"`
+	Status            *string                      `json:"status"`
+	Phase             *string                      `json:"phase"`
+	ResourceStatus    *string                      `json:"resource_status"`
+	CreatedAt         *string                      `json:"created_at"`
+	CreatedBy         *string                      `json:"created_by"`
+	LastModifiedAt    *string                      `json:"last_modified_at"`
+	LastModifiedBy    *string                      `json:"last_modified_by"`
+	ApplicationConfig *databaseV2ApplicationConfig `json:"application_config"`
+	ServiceConfig     *databaseV2ServiceConfig     `json:"service_config"`
+}
+
+type databaseV2ApplicationConfig struct {
+	Instances         *int64                       `json:"instances"`
+	Password          *string                      `json:"password"`
+	Type              *string                      `json:"type"`
+	Version           json.Number                  `json:"version"`
+	Hostname          *string                      `json:"hostname"`
+	IPAddress         *string                      `json:"ip_address"`
+	ScheduledBackups  *databaseV2ScheduledBackups  `json:"scheduled_backups"`
+	Recovery          *databaseV2Recovery          `json:"recovery"`
+	PrivateNetworking *databaseV2PrivateNetworking `json:"private_networking"`
+}
+
+type databaseV2ScheduledBackups struct {
+	Retention *int64 `json:"retention"`
+	Schedule  *struct {
+		Hour   *int64 `json:"hour"`
+		Minute *int64 `json:"minute"`
+	} `json:"schedule"`
+}
+
+type databaseV2Recovery struct {
+	Exclusive  *bool   `json:"exclusive"`
+	Source     *string `json:"source"`
+	TargetLsn  *string `json:"target_lsn"`
+	TargetName *string `json:"target_name"`
+	TargetTime *string `json:"target_time"`
+	TargetXid  *string `json:"target_xid"`
+}
+
+type databaseV2PrivateNetworking struct {
+	Enabled          *bool    `json:"enabled"`
+	AllowedCIDRs     []string `json:"allowed_cidrs"`
+	SharedSubnetCIDR *string  `json:"shared_subnet_cidr"`
+	Hostname         *string  `json:"hostname"`
+	IPAddress        *string  `json:"ip_address"`
+	SharedSubnetID   *string  `json:"shared_subnet_id"`
+	SharedNetworkID  *string  `json:"shared_network_id"`
+}
+
+type databaseV2ServiceConfig struct {
+	Disksize          *int64   `json:"disksize"`
+	Flavor            *string  `json:"flavor"`
+	Region            *string  `json:"region"`
+	Type              *string  `json:"type"`
+	RemoteIps         []string `json:"remote_ips"`
+	MaintenanceWindow *struct {
+		DayOfWeek   *int64 `json:"day_of_week"`
+		StartHour   *int64 `json:"start_hour"`
+		StartMinute *int64 `json:"start_minute"`
+	} `json:"maintenance_window"`
+}
+
+// moveStateFromDatabaseV2 moves a sys11dbaas_database_v2 into a
+// sys11dbaas_database. The deprecated service_config.remote_ips become the
+// allowed_cidrs of application_config.public_networking.
+func moveStateFromDatabaseV2(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != databaseV2TypeName || req.SourceRawState == nil {
+		return
+	}
+	// The registry host is ignored, so moves from mirrors and other
+	// registries like OpenTofu's are accepted as well.
+	provider, err := tfaddr.ParseProviderSource(req.SourceProviderAddress)
+	if err != nil || provider.Namespace != databaseV2ProviderNamespace || provider.Type != databaseV2ProviderType {
+		return
+	}
+
+	var source databaseV2State
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to move database",
+			"Could not decode the state of "+databaseV2TypeName+": "+err.Error(),
+		)
+		return
+	}
+
+	target, diags := databaseV2StateToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+}
+
+func databaseV2StateToModel(ctx context.Context, source databaseV2State) (DatabaseModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	target := DatabaseModel{
+		CreatedBy:      types.StringPointerValue(source.CreatedBy),
+		Description:    types.StringValue(""),
+		LastModifiedBy: types.StringPointerValue(source.LastModifiedBy),
+		Name:           types.StringValue(source.Name),
+		Status:         types.StringPointerValue(source.Status),
+		Phase:          types.StringPointerValue(source.Phase),
+		ResourceStatus: types.StringPointerValue(source.ResourceStatus),
+		Uuid:           types.StringValue(source.UUID),
+		Organization:   types.StringNull(),
+		Project:        types.StringNull(),
+		WaitForReady:   types.BoolNull(),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"read":   types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	if source.Description != nil {
+		target.Description = types.StringValue(*source.Description)
+	}
+
+	createdAt, d := timetypes.NewRFC3339PointerValue(source.CreatedAt)
+	diags.Append(d...)
+	target.CreatedAt = createdAt
+
+	lastModifiedAt, d := timetypes.NewRFC3339PointerValue(source.LastModifiedAt)
+	diags.Append(d...)
+	target.LastModifiedAt = lastModifiedAt
+
+	var remoteIps []string
+	if source.ServiceConfig == nil {
+		target.ServiceConfig = types.ObjectNull(ServiceConfigModel{}.AttributeTypes())
+	} else {
+		remoteIps = source.ServiceConfig.RemoteIps
+
+		maintenanceWindow := types.ObjectNull(MaintenanceWindowModel{}.AttributeTypes())
+		if window := source.ServiceConfig.MaintenanceWindow; window != nil {
+			maintenanceWindow, d = types.ObjectValueFrom(ctx, MaintenanceWindowModel{}.AttributeTypes(), MaintenanceWindowModel{
+				DayOfWeek:   types.Int64PointerValue(window.DayOfWeek),
+				StartHour:   types.Int64PointerValue(window.StartHour),
+				StartMinute: types.Int64PointerValue(window.StartMinute),
+			})
+			diags.Append(d...)
+		}
+
+		serviceConfigType := types.StringValue("database")
+		if source.ServiceConfig.Type != nil {
+			serviceConfigType = types.StringValue(*source.ServiceConfig.Type)
+		}
+
+		target.ServiceConfig, d = types.ObjectValueFrom(ctx, ServiceConfigModel{}.AttributeTypes(), ServiceConfigModel{
+			Disksize:          types.Int64PointerValue(source.ServiceConfig.Disksize),
+			Flavor:            types.StringPointerValue(source.ServiceConfig.Flavor),
+			MaintenanceWindow: maintenanceWindow,
+			Region:            types.StringPointerValue(source.ServiceConfig.Region),
+			RemoteIps:         types.ListNull(types.StringType),
+			ServiceConfigType: serviceConfigType,
+		})
+		diags.Append(d...)
+	}
+
+	if source.ApplicationConfig == nil {
+		target.ApplicationConfig = types.ObjectNull(ApplicationConfigModel{}.AttributeTypes())
+		return target, diags
+	}
+	applicationConfig := source.ApplicationConfig
+
+	scheduledBackups := types.ObjectNull(ScheduledBackupsModel{}.AttributeTypes())
+	if backups := applicationConfig.ScheduledBackups; backups != nil {
+		schedule := types.ObjectNull(ScheduleModel{}.AttributeTypes())
+		if backups.Schedule != nil {
+			schedule, d = types.ObjectValueFrom(ctx, ScheduleModel{}.AttributeTypes(), ScheduleModel{
+				Hour:   types.Int64PointerValue(backups.Schedule.Hour),
+				Minute: types.Int64PointerValue(backups.Schedule.Minute),
+			})
+			diags.Append(d...)
+		}
+
+		scheduledBackups, d = types.ObjectValueFrom(ctx, ScheduledBackupsModel{}.AttributeTypes(), ScheduledBackupsModel{
+			Retention: types.Int64PointerValue(backups.Retention),
+			Schedule:  schedule,
+		})
+		diags.Append(d...)
+	}
+
+	recovery := types.ObjectNull(RecoveryModel{}.AttributeTypes())
+	if r := applicationConfig.Recovery; r != nil {
+		recovery, d = types.ObjectValueFrom(ctx, RecoveryModel{}.AttributeTypes(), RecoveryModel{
+			Exclusive:  types.BoolPointerValue(r.Exclusive),
+			Source:     types.StringPointerValue(r.Source),
+			TargetLsn:  types.StringPointerValue(r.TargetLsn),
+			TargetName: types.StringPointerValue(r.TargetName),
+			TargetTime: types.StringPointerValue(r.TargetTime),
+			TargetXid:  types.StringPointerValue(r.TargetXid),
+		})
+		diags.Append(d...)
+	}
+
+	privateNetworking := types.ObjectNull(PrivateNetworkingModel{}.AttributeTypes())
+	if network := applicationConfig.PrivateNetworking; network != nil {
+		allowedCIDRs, d := types.ListValueFrom(ctx, types.StringType, network.AllowedCIDRs)
+		diags.Append(d...)
+
+		privateNetworking, d = types.ObjectValueFrom(ctx, PrivateNetworkingModel{}.AttributeTypes(), PrivateNetworkingModel{
+			Enabled:          types.BoolPointerValue(network.Enabled),
+			AllowedCIDRs:     allowedCIDRs,
+			SharedSubnetCIDR: types.StringPointerValue(network.SharedSubnetCIDR),
+			Hostname:         types.StringPointerValue(network.Hostname),
+			IPAddress:        types.StringPointerValue(network.IPAddress),
+			SharedSubnetID:   types.StringPointerValue(network.SharedSubnetID),
+			SharedNetworkID:  types.StringPointerValue(network.SharedNetworkID),
+		})
+		diags.Append(d...)
+	}
+
+	// The v2 resource only knew public access, restricted by remote_ips.
+	publicNetworking := types.ObjectNull(PublicNetworkingModel{}.AttributeTypes())
+	if len(remoteIps) > 0 {
+		allowedCIDRs, d := types.ListValueFrom(ctx, types.StringType, remoteIps)
+		diags.Append(d...)
+
+		publicNetworking, d = types.ObjectValueFrom(ctx, PublicNetworkingModel{}.AttributeTypes(), PublicNetworkingModel{
+			Enabled:      types.BoolValue(true),
+			AllowedCIDRs: allowedCIDRs,
+			Hostname:     types.StringPointerValue(applicationConfig.Hostname),
+			IPAddress:    types.StringPointerValue(applicationConfig.IPAddress),
+		})
+		diags.Append(d...)
+	}
+
+	version := types.StringNull()
+	if applicationConfig.Version != "" {
+		version = types.StringValue(applicationConfig.Version.String())
+	}
+
+	target.ApplicationConfig, d = types.ObjectValueFrom(ctx, ApplicationConfigModel{}.AttributeTypes(), ApplicationConfigModel{
+		Instances:             types.Int64PointerValue(applicationConfig.Instances),
+		Password:              types.StringPointerValue(applicationConfig.Password),
+		PasswordWO:            types.StringNull(),
+		PasswordWOVersion:     types.Int64Null(),
+		PasswordPolicy:        types.ObjectNull(PasswordPolicyModel{}.AttributeTypes()),
+		PasswordTrigger:       types.StringNull(),
+		PasswordRotatedAt:     timetypes.NewRFC3339Null(),
+		Recovery:              recovery,
+		ScheduledBackups:      scheduledBackups,
+		PrivateNetworking:     privateNetworking,
+		PublicNetworking:      publicNetworking,
+		ApplicationConfigType: types.StringPointerValue(applicationConfig.Type),
+		Version:               version,
+		Features:              types.MapNull(types.StringType),
+		EffectiveFeatures:     types.MapNull(types.StringType),
+	})
+	diags.Append(d...)
+
+	return target, diags
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const databaseV2StateFixture = `{
+  "uuid": "2c3f4a8e-5d61-4c1a-9e0b-7f1d2a3b4c5d",
+  "name": "example-postgresql",
+  "description": "moved database",
+  "status": "Ready",
+  "phase": "Running",
+  "resource_status": "Synced",
+  "created_at": "2025-01-02T03:04:05Z",
+  "created_by": "someone@example.com",
+  "last_modified_at": "2025-02-03T04:05:06Z",
+  "last_modified_by": "someone@example.com",
+  "application_config": {
+    "instances": 3,
+    "password": "veryS3cretPassword",
+    "type": "postgresql",
+    "version": 16.8,
+    "hostname": "2c3f4a8e.postgresql.syseleven.services",
+    "ip_address": "198.51.100.7",
+    "scheduled_backups": {
+      "retention": 7,
+      "schedule": {"hour": 3, "minute": 15}
+    },
+    "recovery": null
+  },
+  "service_config": {
+    "disksize": 25,
+    "flavor": "SCS-2V-4-50n",
+    "region": "dus2",
+    "type": "database",
+    "remote_ips": ["192.0.2.0/24", "203.0.113.1/32"],
+    "maintenance_window": {"day_of_week": 2, "start_hour": 22, "start_minute": 30},
+    "some_removed_attribute": "ignored"
+  }
+}`
+
+func moveDatabaseState(t *testing.T, typeName, providerAddress, rawState string) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	s := schemaV0(ctx)
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+	}
+	req := resource.MoveStateRequest{
+		SourceTypeName:        typeName,
+		SourceProviderAddress: providerAddress,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+
+	for _, mover := range (&DatabaseResource{}).MoveState(ctx) {
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+
+	return resp
+}
+
+func TestDatabaseResourceMoveStateFromV2(t *testing.T) {
+	ctx := context.Background()
+	resp := moveDatabaseState(t, "sys11dbaas_database_v2", "registry.terraform.io/syseleven/sys11dbaas", databaseV2StateFixture)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var model DatabaseModel
+	resp.Diagnostics.Append(resp.TargetState.Get(ctx, &model)...)
+	if model.Uuid.ValueString() != "2c3f4a8e-5d61-4c1a-9e0b-7f1d2a3b4c5d" || model.Name.ValueString() != "example-postgresql" {
+		t.Errorf("unexpected uuid %s or name %s", model.Uuid, model.Name)
+	}
+	if model.Description.ValueString() != "moved database" || model.Status.ValueString() != "Ready" {
+		t.Errorf("unexpected description %s or status %s", model.Description, model.Status)
+	}
+	if model.CreatedAt.ValueString() != "2025-01-02T03:04:05Z" {
+		t.Errorf("unexpected created_at %s", model.CreatedAt)
+	}
+
+	stringAttributes := []struct {
+		path path.Path
+		want string
+	}{
+		{path.Root("application_config").AtName("password"), "veryS3cretPassword"},
+		{path.Root("application_config").AtName("version"), "16.8"},
+		{path.Root("application_config").AtName("public_networking").AtName("hostname"), "2c3f4a8e.postgresql.syseleven.services"},
+		{path.Root("application_config").AtName("public_networking").AtName("ip_address"), "198.51.100.7"},
+		{path.Root("service_config").AtName("flavor"), "SCS-2V-4-50n"},
+		{path.Root("service_config").AtName("region"), "dus2"},
+	}
+	for _, attribute := range stringAttributes {
+		var got types.String
+		resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, attribute.path, &got)...)
+		if got.ValueString() != attribute.want {
+			t.Errorf("%s = %q, want %q", attribute.path, got.ValueString(), attribute.want)
+		}
+	}
+
+	var enabled types.Bool
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("application_config").AtName("public_networking").AtName("enabled"), &enabled)...)
+	if !enabled.ValueBool() {
+		t.Error("expected public networking to be enabled")
+	}
+
+	var allowedCIDRs []string
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("application_config").AtName("public_networking").AtName("allowed_cidrs"), &allowedCIDRs)...)
+	if len(allowedCIDRs) != 2 || allowedCIDRs[0] != "192.0.2.0/24" || allowedCIDRs[1] != "203.0.113.1/32" {
+		t.Errorf("unexpected public_networking.allowed_cidrs %v", allowedCIDRs)
+	}
+
+	var remoteIps types.List
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("service_config").AtName("remote_ips"), &remoteIps)...)
+	if !remoteIps.IsNull() {
+		t.Errorf("expected service_config.remote_ips to be null, got %s", remoteIps)
+	}
+
+	var hour, startMinute types.Int64
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("application_config").AtName("scheduled_backups").AtName("schedule").AtName("hour"), &hour)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("service_config").AtName("maintenance_window").AtName("start_minute"), &startMinute)...)
+	if hour.ValueInt64() != 3 || startMinute.ValueInt64() != 30 {
+		t.Errorf("unexpected backup hour %s or maintenance start minute %s", hour, startMinute)
+	}
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
+
+func TestDatabaseResourceMoveStateFromV2WithoutRemoteIps(t *testing.T) {
+	ctx := context.Background()
+	resp := moveDatabaseState(t, "sys11dbaas_database_v2", "registry.terraform.io/syseleven/sys11dbaas", `{
+  "uuid": "2c3f4a8e-5d61-4c1a-9e0b-7f1d2a3b4c5d",
+  "name": "example-postgresql",
+  "application_config": {"instances": 1, "type": "postgresql", "version": "17.4"},
+  "service_config": {"disksize": 25, "flavor": "SCS-2V-4-50n", "region": "dus2"}
+}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var publicNetworking types.Object
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("application_config").AtName("public_networking"), &publicNetworking)...)
+	if !publicNetworking.IsNull() {
+		t.Errorf("expected public_networking to be null, got %s", publicNetworking)
+	}
+
+	var version, serviceType types.String
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("application_config").AtName("version"), &version)...)
+	resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("service_config").AtName("type"), &serviceType)...)
+	if version.ValueString() != "17.4" || serviceType.ValueString() != "database" {
+		t.Errorf("unexpected version %s or service type %s", version, serviceType)
+	}
+}
+
+func TestDatabaseResourceMoveStateFromOtherRegistry(t *testing.T) {
+	ctx := context.Background()
+	resp := moveDatabaseState(t, "sys11dbaas_database_v2", "registry.opentofu.org/syseleven/sys11dbaas", databaseV2StateFixture)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var model DatabaseModel
+	resp.Diagnostics.Append(resp.TargetState.Get(ctx, &model)...)
+	if model.Uuid.ValueString() != "2c3f4a8e-5d61-4c1a-9e0b-7f1d2a3b4c5d" {
+		t.Errorf("unexpected uuid %s", model.Uuid)
+	}
+}
+
+func TestDatabaseResourceMoveStateIgnoresOtherSources(t *testing.T) {
+	tests := map[string]struct {
+		typeName        string
+		providerAddress string
+	}{
+		"other resource type": {typeName: "sys11dbaas_features", providerAddress: "registry.terraform.io/syseleven/sys11dbaas"},
+		"other provider":      {typeName: "sys11dbaas_database_v2", providerAddress: "registry.terraform.io/example/sys11dbaas"},
+		"other provider type": {typeName: "sys11dbaas_database_v2", providerAddress: "registry.terraform.io/syseleven/example"},
+		"invalid address":     {typeName: "sys11dbaas_database_v2", providerAddress: "not a provider address"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := moveDatabaseState(t, tt.typeName, tt.providerAddress, databaseV2StateFixture)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.TargetState.Raw.IsNull() {
+				t.Error("expected the target state to stay empty")
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceMoveStateInvalidJSON(t *testing.T) {
+	resp := moveDatabaseState(t, "sys11dbaas_database_v2", "registry.terraform.io/syseleven/sys11dbaas", `{"uuid": 42}`)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for undecodable state")
+	}
+}
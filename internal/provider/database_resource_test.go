@@ -1,22 +1,50 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	sys11dbaassdk "github.com/syseleven/sys11dbaas-sdk"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
 )
 
 func TestDatabaseResource(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
 	resourceName := acctest.RandomWithPrefix("create_read")
 	resource.ParallelTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Delete waits until the database is gone, so nothing may be left behind.
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, ok := fake.Database(resourceName); ok {
+				return fmt.Errorf("database %s still exists", resourceName)
+			}
+			return nil
+		},
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: providerConfig + fmt.Sprintf(`
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
 resource "sys11dbaas_database" "test" {
   name = "%s"
   application_config = {
@@ -45,7 +73,7 @@ resource "sys11dbaas_database" "test" {
 					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.type", "postgresql"),
 					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.version", "17.4"),
 					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.public_networking.allowed_cidrs.0", "0.0.0.0/0"),
-					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", "ClusterIsReady"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", database.StateReady),
 
 					// Verify dynamic values have any value set in the state.
 					resource.TestCheckResourceAttrSet("sys11dbaas_database.test", "uuid"),
@@ -72,7 +100,7 @@ resource "sys11dbaas_database" "test" {
 			},
 			// Update and Read testing
 			{
-				Config: providerConfig + fmt.Sprintf(`
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
 resource "sys11dbaas_database" "test" {
   name = "%s"
   application_config = {
@@ -98,7 +126,125 @@ resource "sys11dbaas_database" "test" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.public_networking.enabled", "true"),
 					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.public_networking.allowed_cidrs.0", "1.1.1.1/32"),
-					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", "ClusterIsReady"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", database.StateReady),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDatabaseResourceCreateTimeout(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.SetPollsUntilReady(1000)
+	resourceName := acctest.RandomWithPrefix("create_timeout")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create runs into the timeout while the database is still provisioning
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+    public_networking = {
+      enabled            = true
+      allowed_cidrs = [
+      	"0.0.0.0/0"
+      ]
+    }
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+
+  timeouts {
+    create = "1s"
+  }
+}
+`, resourceName),
+				ExpectError: regexp.MustCompile(`Last observed status: "Creating"`),
+			},
+			// The tainted database is replaced once the API settles again
+			{
+				PreConfig: func() {
+					fake.SetPollsUntilReady(1)
+				},
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+    public_networking = {
+      enabled            = true
+      allowed_cidrs = [
+      	"0.0.0.0/0"
+      ]
+    }
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+}
+`, resourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", database.StateReady),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDatabaseResourceWithoutWaitForReady(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.SetPollsUntilReady(1000)
+	resourceName := acctest.RandomWithPrefix("no_wait")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create returns while the database is still provisioning
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+
+  wait_for_ready = false
+}
+`, resourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sys11dbaas_database.test", "uuid"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", testhelpers.FakeStateCreating),
+					func(_ *terraform.State) error {
+						// Let the deletion at the end of the test settle quickly.
+						fake.SetPollsUntilReady(1)
+						return nil
+					},
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -106,6 +252,1036 @@ resource "sys11dbaas_database" "test" {
 	})
 }
 
+func TestDatabaseResourceWaitUntilDeleted(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	client := newTestClient(t, fake.URL())
+
+	created, err := client.V2().CreatePostgreSQL(ctx, testhelpers.FakeOrganization, testhelpers.FakeProject, database.PostgreSQLCreateRequest{
+		Name: "wait-until-deleted",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake.SetPollsUntilReady(3)
+	if _, err := client.V2().DeletePostgreSQL(ctx, testhelpers.FakeOrganization, testhelpers.FakeProject, created.Uuid); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &DatabaseResource{
+		client:       client.V2(),
+		organization: types.StringValue(testhelpers.FakeOrganization),
+		project:      types.StringValue(testhelpers.FakeProject),
+		polling: pollingConfig{
+			initialInterval: 10 * time.Millisecond,
+			maxInterval:     10 * time.Millisecond,
+			multiplier:      1,
+		},
+	}
+
+	last, err := r.waitUntilDeleted(ctx, testhelpers.FakeOrganization, testhelpers.FakeProject, created.Uuid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The fake reports the database as deleting for three polls before it
+	// answers with 404.
+	if last.Status != testhelpers.FakeStateDeleting {
+		t.Errorf("expected the last observed status to be %q, got %q", testhelpers.FakeStateDeleting, last.Status)
+	}
+	if _, ok := fake.Database("wait-until-deleted"); ok {
+		t.Error("expected the database to be gone")
+	}
+}
+
+func TestDatabaseResourceDisappears(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	resourceName := acctest.RandomWithPrefix("disappears")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+}
+`, resourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sys11dbaas_database.test", "uuid"),
+					func(_ *terraform.State) error {
+						if !fake.RemoveDatabase(resourceName) {
+							return fmt.Errorf("database %s not found", resourceName)
+						}
+						return nil
+					},
+				),
+				// The refresh after apply drops the database from state.
+				ExpectNonEmptyPlan: true,
+			},
+			// The next plan offers to recreate the database
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+}
+`, resourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", database.StateReady),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceReadNotReady(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	resourceName := acctest.RandomWithPrefix("read_not_ready")
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+%s}
+`, resourceName, `
+  timeouts {
+    read = "5s"
+  }
+`),
+			},
+			// Read returns right away for a database in maintenance
+			{
+				PreConfig: func() {
+					fake.HoldDatabase(resourceName, "Maintenance", "Maintenance")
+				},
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+%s}
+`, resourceName, `
+  timeouts {
+    read = "5s"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", "Maintenance"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "phase", "Maintenance"),
+				),
+			},
+			// Opting in to wait on read blocks until the read timeout
+			{
+				Config: fake.ProviderConfig("wait_for_ready_on_read = true") + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "%s"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+%s}
+`, resourceName, `
+  timeouts {
+    read = "1s"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Timeout reading database`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceInvalidCatalogValues(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + `
+resource "sys11dbaas_database" "test" {
+  name = "invalid-catalog-values"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.3
+    password = "test_test_test_test"
+    public_networking = {
+      enabled = true
+    }
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50"
+    region     = "dus2"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Invalid flavor.*Did you mean "SCS-2V-4-50n"\?.*Invalid version.*Did you mean "17.4"\?`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceFeatureDefaults(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.Features = []database.Feature{
+		{Id: "pg_stat_statements", Default: "on"},
+		{Id: "pgaudit", Default: "off"},
+	}
+	config := fake.ProviderConfig() + `
+resource "sys11dbaas_database" "test" {
+  name = "feature-defaults"
+  application_config = {
+    instances = 1
+    type      = "postgresql"
+    version   = 17.4
+    password = "test_test_test_test"
+    features = {
+      pgaudit = "on"
+    }
+  }
+
+  service_config = {
+    disksize   = 25
+    flavor     = "SCS-2V-4-50n"
+    region     = "dus2"
+  }
+}
+`
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.features.%", "1"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.effective_features.%", "2"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.effective_features.pg_stat_statements", "on"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.effective_features.pgaudit", "on"),
+				),
+			},
+			// The defaults applied by the API do not cause a diff.
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config:      strings.Replace(config, "pgaudit = \"on\"", "pg_audit = \"on\"", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Invalid feature.*Did you mean "pgaudit"\?`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceWriteOnlyPassword(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	config := func(password, description string, version int) string {
+		return fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name        = "write-only-password"
+  description = %q
+  application_config = {
+    instances           = 1
+    version             = "17.4"
+    password_wo         = %q
+    password_wo_version = %d
+    public_networking = {
+      enabled = false
+    }
+  }
+
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`, description, password, version)
+	}
+	checkPassword := func(want string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if got, _ := fake.Password("write-only-password"); got != want {
+				return fmt.Errorf("password sent to the API is %q, want %q", got, want)
+			}
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("first-password-0001", "first", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkPassword("first-password-0001"),
+					resource.TestCheckNoResourceAttr("sys11dbaas_database.test", "application_config.password_wo"),
+					resource.TestCheckNoResourceAttr("sys11dbaas_database.test", "application_config.password"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.password_wo_version", "1"),
+				),
+			},
+			// A new write-only password is ignored until its version changes.
+			{
+				Config: config("second-password-002", "second", 1),
+				Check:  checkPassword("first-password-0001"),
+			},
+			{
+				Config: config("second-password-002", "second", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkPassword("second-password-002"),
+					resource.TestCheckNoResourceAttr("sys11dbaas_database.test", "application_config.password_wo"),
+				),
+			},
+			{
+				Config:      strings.Replace(config("second-password-002", "second", 2), "instances", "password = \"legacy-password-0003\"\n    instances", 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceSwitchToWriteOnlyPassword(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	config := func(password string) string {
+		return fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "switch-to-write-only"
+  application_config = {
+    instances = 1
+    version   = "17.4"
+    %s
+    public_networking = {
+      enabled = false
+    }
+  }
+
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`, password)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(`password = "legacy-password-0001"`),
+			},
+			{
+				Config:      config(`password_wo = "write-only-password-01"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: config("password_wo = \"write-only-password-01\"\n    password_wo_version = 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(*terraform.State) error {
+						if got, _ := fake.Password("switch-to-write-only"); got != "write-only-password-01" {
+							return fmt.Errorf("password sent to the API is %q, want the write-only password", got)
+						}
+						return nil
+					},
+					resource.TestCheckNoResourceAttr("sys11dbaas_database.test", "application_config.password"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceUpdateSwitchesToWriteOnlyPassword(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "switch-to-write-only")
+	r := newFakeDatabaseResource(t, fake)
+	passwordPath := path.Root("application_config").AtName("password")
+
+	response, _ := fake.Database("switch-to-write-only")
+	s := schemaV0(ctx)
+	state := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	state.SetAttribute(ctx, passwordPath, "legacy-password-0001")
+
+	// Replacing the legacy password sends password_wo.
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	plan.SetAttribute(ctx, passwordPath, types.StringNull())
+	plan.SetAttribute(ctx, path.Root("application_config").AtName("password_wo_version"), 1)
+	config := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	config.SetAttribute(ctx, passwordPath, types.StringNull())
+	config.SetAttribute(ctx, passwordWOPath, "write-only-password-01")
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: state.Raw}}
+	r.Update(ctx, fwresource.UpdateRequest{Config: tfsdk.Config(config), Plan: plan, State: tfsdk.State{Schema: s, Raw: state.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if got, _ := fake.Password("switch-to-write-only"); got != "write-only-password-01" {
+		t.Errorf("password sent to the API is %q, want the write-only password", got)
+	}
+	var password types.String
+	resp.State.GetAttribute(ctx, passwordPath, &password)
+	if !password.IsNull() {
+		t.Errorf("password = %s, want null", password)
+	}
+}
+
+func TestDatabaseResourceGeneratedPassword(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	config := func(policy string) string {
+		return fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name = "generated-password"
+  application_config = {
+    instances = 1
+    version   = "17.4"
+    password_policy = {
+      %s
+    }
+    public_networking = {
+      enabled = false
+    }
+  }
+
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`, policy)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("length = 24\n      special = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.password_policy.length", "24"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "application_config.password_policy.lower", "true"),
+					resource.TestCheckResourceAttrWith("sys11dbaas_database.test", "application_config.password", func(value string) error {
+						if sent, _ := fake.Password("generated-password"); value != sent {
+							return fmt.Errorf("password in state differs from the one sent to the API")
+						}
+						if !regexp.MustCompile(`^[a-zA-Z0-9]{24}$`).MatchString(value) {
+							return fmt.Errorf("password does not follow the policy")
+						}
+						return nil
+					}),
+				),
+			},
+			// The generated password is kept.
+			{
+				Config:   config("length = 24\n      special = false"),
+				PlanOnly: true,
+			},
+			{
+				Config:      config("lower = false\n      upper = false\n      numeric = false\n      special = false"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid password policy`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourcePasswordRotation(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	config := func(trigger, description string) string {
+		return fake.ProviderConfig() + fmt.Sprintf(`
+resource "sys11dbaas_database" "test" {
+  name        = "password-rotation"
+  description = %q
+  application_config = {
+    instances                 = 1
+    version                   = "17.4"
+    password_rotation_trigger = %q
+    public_networking = {
+      enabled = false
+    }
+  }
+
+  service_config = {
+    disksize = 25
+    flavor   = "SCS-2V-4-50n"
+    region   = "dus2"
+  }
+}
+`, description, trigger)
+	}
+
+	var previous string
+	checkRotated := func(rotated bool) resource.TestCheckFunc {
+		return resource.TestCheckResourceAttrWith("sys11dbaas_database.test", "application_config.password", func(value string) error {
+			if sent, _ := fake.Password("password-rotation"); value != sent {
+				return fmt.Errorf("password in state differs from the one sent to the API")
+			}
+			if rotated == (value == previous) {
+				return fmt.Errorf("password rotated: %t, want %t", !rotated, rotated)
+			}
+			previous = value
+			return nil
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRotated(true),
+					resource.TestCheckResourceAttrSet("sys11dbaas_database.test", "application_config.password_last_rotated_at"),
+				),
+			},
+			{
+				Config: config("1", "second"),
+				Check:  checkRotated(false),
+			},
+			{
+				Config: config("2", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRotated(true),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "description", "second"),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "status", "Ready"),
+				),
+			},
+			// Rotating along with other changes sends the full configuration.
+			{
+				Config: config("3", "third"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRotated(true),
+					resource.TestCheckResourceAttr("sys11dbaas_database.test", "description", "third"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceModifyPlanPasswordRotation(t *testing.T) {
+	ctx := context.Background()
+	r := newFakeDatabaseResource(t, testhelpers.NewFakeDBaaS(t))
+	passwordPath := path.Root("application_config").AtName("password")
+	triggerPath := path.Root("application_config").AtName("password_rotation_trigger")
+
+	response := testDatabaseResponse()
+	s := schemaV0(ctx)
+	state := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	state.SetAttribute(ctx, passwordPath, "generated-password-01")
+	state.SetAttribute(ctx, passwordRotatedAtPath, "2026-01-02T03:04:05Z")
+	state.SetAttribute(ctx, triggerPath, "1")
+
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	plan.SetAttribute(ctx, passwordPath, "generated-password-01")
+	plan.SetAttribute(ctx, passwordRotatedAtPath, "2026-01-02T03:04:05Z")
+	plan.SetAttribute(ctx, triggerPath, "1")
+	config := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	config.SetAttribute(ctx, triggerPath, "1")
+
+	modifyPlan := func(t *testing.T) (types.String, timetypes.RFC3339) {
+		t.Helper()
+		req := fwresource.ModifyPlanRequest{Config: tfsdk.Config(config), Plan: plan, State: tfsdk.State{Schema: s, Raw: state.Raw}}
+		resp := &fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		var password types.String
+		var rotatedAt timetypes.RFC3339
+		resp.Plan.GetAttribute(ctx, passwordPath, &password)
+		resp.Plan.GetAttribute(ctx, passwordRotatedAtPath, &rotatedAt)
+		return password, rotatedAt
+	}
+
+	password, rotatedAt := modifyPlan(t)
+	if password.ValueString() != "generated-password-01" || rotatedAt.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Errorf("got password %s rotated at %s, want both kept from state", password, rotatedAt)
+	}
+
+	plan.SetAttribute(ctx, triggerPath, "2")
+	config.SetAttribute(ctx, triggerPath, "2")
+	password, rotatedAt = modifyPlan(t)
+	if !password.IsUnknown() || !rotatedAt.IsUnknown() {
+		t.Errorf("got password %s rotated at %s, want both unknown", password, rotatedAt)
+	}
+
+	// A configured password is sent again, but not replaced.
+	config.SetAttribute(ctx, passwordPath, "generated-password-01")
+	password, rotatedAt = modifyPlan(t)
+	if password.ValueString() != "generated-password-01" || !rotatedAt.IsUnknown() {
+		t.Errorf("got password %s rotated at %s, want the configured password and unknown", password, rotatedAt)
+	}
+}
+
+func TestOnlyPasswordChanged(t *testing.T) {
+	ctx := context.Background()
+	response := testDatabaseResponse()
+	newModel := func(t *testing.T, set func(*tfsdk.Plan)) DatabaseModel {
+		t.Helper()
+		plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+		set(&plan)
+		var model DatabaseModel
+		if diags := plan.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return model
+	}
+	state := newModel(t, func(*tfsdk.Plan) {})
+
+	tests := map[string]struct {
+		set  func(*tfsdk.Plan)
+		want bool
+	}{
+		"password": {
+			set: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("application_config").AtName("password"), "new-password-000001")
+				plan.SetAttribute(ctx, path.Root("application_config").AtName("password_rotation_trigger"), "2")
+			},
+			want: true,
+		},
+		"description": {
+			set: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("application_config").AtName("password_rotation_trigger"), "2")
+				plan.SetAttribute(ctx, path.Root("description"), "changed")
+			},
+		},
+		"instances": {
+			set: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("application_config").AtName("instances"), 3)
+			},
+		},
+		"disksize": {
+			set: func(plan *tfsdk.Plan) {
+				plan.SetAttribute(ctx, path.Root("service_config").AtName("disksize"), 50)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := onlyPasswordChanged(ctx, newModel(t, tt.set), state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("onlyPasswordChanged() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDatabaseResourceUpdateRotatesPassword(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.Features = []database.Feature{{Id: "pg_stat_statements", Default: "enabled"}}
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "password-rotation")
+	r := newFakeDatabaseResource(t, fake)
+	passwordPath := path.Root("application_config").AtName("password")
+	triggerPath := path.Root("application_config").AtName("password_rotation_trigger")
+
+	response, _ := fake.Database("password-rotation")
+	s := schemaV0(ctx)
+	state := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	state.SetAttribute(ctx, passwordPath, "generated-password-01")
+	state.SetAttribute(ctx, triggerPath, "1")
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	plan.SetAttribute(ctx, passwordPath, types.StringUnknown())
+	plan.SetAttribute(ctx, passwordRotatedAtPath, timetypes.NewRFC3339Unknown())
+	plan.SetAttribute(ctx, triggerPath, "2")
+	plan.SetAttribute(ctx, path.Root("wait_for_ready"), false)
+	config := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	config.SetAttribute(ctx, passwordPath, types.StringNull())
+	config.SetAttribute(ctx, triggerPath, "2")
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: state.Raw}}
+	r.Update(ctx, fwresource.UpdateRequest{Config: tfsdk.Config(config), Plan: plan, State: tfsdk.State{Schema: s, Raw: state.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var model DatabaseModel
+	var applicationConfig ApplicationConfigModel
+	resp.State.Get(ctx, &model)
+	model.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})
+	sent, _ := fake.Password("password-rotation")
+	if sent == "generated-password-01" || applicationConfig.Password.ValueString() != sent {
+		t.Errorf("password in state is %s, sent %q, want a new password in both", applicationConfig.Password, sent)
+	}
+	if applicationConfig.PasswordRotatedAt.IsNull() || applicationConfig.PasswordRotatedAt.IsUnknown() {
+		t.Errorf("password_last_rotated_at = %s, want the time of the rotation", applicationConfig.PasswordRotatedAt)
+	}
+	// The rotation waits for the database, even without wait_for_ready.
+	if model.Status.ValueString() != database.StateReady {
+		t.Errorf("status = %s, want %s", model.Status, database.StateReady)
+	}
+
+	// The request is built from state, so the defaults of the API are not
+	// pinned.
+	request, _ := fake.LastUpdate("password-rotation")
+	if request.ApplicationConfig.Features != nil {
+		t.Errorf("sent features %v, want none", *request.ApplicationConfig.Features)
+	}
+}
+
+func TestDatabaseResourceModifyPlanDropsLegacyPassword(t *testing.T) {
+	ctx := context.Background()
+	r := newFakeDatabaseResource(t, testhelpers.NewFakeDBaaS(t))
+	passwordPath := path.Root("application_config").AtName("password")
+
+	response := testDatabaseResponse()
+	s := schemaV0(ctx)
+	state := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	state.SetAttribute(ctx, passwordPath, "legacy-password-0001")
+
+	// The legacy password is kept from state while it is not configured.
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	plan.SetAttribute(ctx, passwordPath, "legacy-password-0001")
+	config := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	req := fwresource.ModifyPlanRequest{Config: tfsdk.Config(config), Plan: plan, State: tfsdk.State{Schema: s, Raw: state.Raw}}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+
+	var password types.String
+	resp.Plan.GetAttribute(ctx, passwordPath, &password)
+	if password.ValueString() != "legacy-password-0001" {
+		t.Errorf("password = %s, want the value from state", password)
+	}
+
+	// Switching to password_wo removes it.
+	config.SetAttribute(ctx, passwordWOPath, "write-only-password")
+	req.Config = tfsdk.Config(config)
+	resp = &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	resp.Plan.GetAttribute(ctx, passwordPath, &password)
+	if !password.IsNull() {
+		t.Errorf("password = %s, want null", password)
+	}
+}
+
+// newFakeDatabaseResource returns a DatabaseResource configured against fake.
+func newFakeDatabaseResource(t *testing.T, fake *testhelpers.FakeDBaaS) *DatabaseResource {
+	t.Helper()
+
+	client := newTestClient(t, fake.URL())
+
+	return &DatabaseResource{
+		client:       client.V2(),
+		organization: types.StringValue(testhelpers.FakeOrganization),
+		project:      types.StringValue(testhelpers.FakeProject),
+		polling: pollingConfig{
+			initialInterval: 10 * time.Millisecond,
+			maxInterval:     10 * time.Millisecond,
+			multiplier:      1,
+		},
+		catalogs: newCatalogCache(client.V2(), testhelpers.FakeOrganization, testhelpers.FakeProject, defaultCatalogCacheTTL),
+	}
+}
+
+func TestDatabaseResourceModifyPlanCatalogValues(t *testing.T) {
+	ctx := context.Background()
+	r := newFakeDatabaseResource(t, testhelpers.NewFakeDBaaS(t))
+
+	response := testDatabaseResponse()
+	response.ServiceConfig.Flavor = "SCS-2V-4-50"
+	response.ServiceConfig.Region = "dus2"
+	response.ApplicationConfig.Version = "17.4"
+
+	s := schemaV0(ctx)
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config(plan),
+		Plan:   plan,
+		State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+
+	if got := resp.Diagnostics.ErrorsCount(); got != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", got, resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `Did you mean "SCS-2V-4-50n"?`) {
+		t.Errorf("expected a suggestion, got %q", detail)
+	}
+
+	// The same value is not validated again once it is in state.
+	req.State = tfsdk.State{Schema: s, Raw: plan.Raw}
+	resp = &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected diagnostics for unchanged values: %v", resp.Diagnostics)
+	}
+}
+
+func TestDatabaseResourceModifyPlanFeatures(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.Features = []database.Feature{
+		{Id: "pg_stat_statements", Default: "on"},
+		{Id: "pgaudit", Default: "off"},
+	}
+	r := newFakeDatabaseResource(t, fake)
+
+	features, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"pg_stat_statement": "on", "pgaudit": "on"})
+	s := schemaV0(ctx)
+	plan := testDatabasePlan(t, testDatabaseResponse(), features)
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config(plan),
+		Plan:   plan,
+		State:  tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+
+	if got := resp.Diagnostics.ErrorsCount(); got != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", got, resp.Diagnostics)
+	}
+	wantPath := path.Root("application_config").AtName("features").AtMapKey("pg_stat_statement")
+	if got, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !got.Path().Equal(wantPath) {
+		t.Errorf("expected the error at %s, got %v", wantPath, resp.Diagnostics.Errors()[0])
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `Did you mean "pg_stat_statements"?`) {
+		t.Errorf("expected a suggestion, got %q", detail)
+	}
+}
+
+func TestDatabaseResourceModifyPlanKeepsEffectiveFeatures(t *testing.T) {
+	ctx := context.Background()
+	r := newFakeDatabaseResource(t, testhelpers.NewFakeDBaaS(t))
+
+	response := testDatabaseResponse()
+	response.ApplicationConfig.Features = &map[string]database.PostgreSQLApplicationConfigFeatures{"pgaudit": "on", "pg_stat_statements": "on"}
+	features, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"pgaudit": "on"})
+	s := schemaV0(ctx)
+	state := testDatabasePlan(t, response, features)
+
+	// The framework marks computed attributes as unknown when anything changes.
+	plan := testDatabasePlan(t, response, features)
+	plan.SetAttribute(ctx, path.Root("name"), "renamed")
+	plan.SetAttribute(ctx, path.Root("application_config").AtName("effective_features"), types.MapUnknown(types.StringType))
+
+	req := fwresource.ModifyPlanRequest{Config: tfsdk.Config(plan), Plan: plan, State: tfsdk.State{Schema: s, Raw: state.Raw}}
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var effectiveFeatures types.Map
+	resp.Plan.GetAttribute(ctx, path.Root("application_config").AtName("effective_features"), &effectiveFeatures)
+	if len(effectiveFeatures.Elements()) != 2 {
+		t.Errorf("effective_features = %s, want the value from state", effectiveFeatures)
+	}
+
+	// Changed features are applied by the API, so their effect is not known.
+	changed, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"pgaudit": "off"})
+	resp.Plan.SetAttribute(ctx, path.Root("application_config").AtName("features"), changed)
+	resp.Plan.SetAttribute(ctx, path.Root("application_config").AtName("effective_features"), types.MapUnknown(types.StringType))
+	req.Plan = resp.Plan
+	r.ModifyPlan(ctx, req, resp)
+
+	resp.Plan.GetAttribute(ctx, path.Root("application_config").AtName("effective_features"), &effectiveFeatures)
+	if !effectiveFeatures.IsUnknown() {
+		t.Errorf("effective_features = %s, want unknown", effectiveFeatures)
+	}
+}
+
+// testDatabasePlan returns a plan of the database in response with the given
+// configured features.
+func testDatabasePlan(t *testing.T, response database.PostgreSQLGetResponse, features types.Map) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	var model DatabaseModel
+	if diags := psqlGetResponseToModel(ctx, response, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	model.WaitForReady = types.BoolNull()
+	model.Timeouts.Object = types.ObjectNull(map[string]attr.Type{
+		"create": types.StringType,
+		"read":   types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	})
+
+	s := schemaV0(ctx)
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags := plan.SetAttribute(ctx, path.Root("application_config").AtName("features"), features); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return plan
+}
+
+func TestDatabaseResourceWaitForReadyNotFound(t *testing.T) {
+	r := newFakeDatabaseResource(t, testhelpers.NewFakeDBaaS(t))
+
+	_, err := r.waitForReady(context.Background(), testhelpers.FakeOrganization, testhelpers.FakeProject, "00000000-0000-0000-0000-000000000000")
+	if !isNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+}
+
+func TestDatabaseResourceCreateKeepsStateWhenWaitFails(t *testing.T) {
+	ctx := context.Background()
+	fake := testhelpers.NewFakeDBaaS(t)
+	r := newFakeDatabaseResource(t, fake)
+
+	// Creating the database succeeds, but every poll for it is refused.
+	failPolls := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Status:     "403 Forbidden",
+				Body:       io.NopCloser(strings.NewReader(`{"detail": "forbidden"}`)),
+				Request:    req,
+			}, nil
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client, err := sys11dbaassdk.NewClient(
+		fake.URL(),
+		sys11dbaassdk.WithApiKey("fake"),
+		sys11dbaassdk.WithHTTPClient(&http.Client{Transport: newAPIErrorTransport(failPolls)}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.client = client.V2()
+
+	dayOfWeek, startHour, startMinute := int64(0), int64(2), int64(0)
+	response := testDatabaseResponse()
+	response.Name = "wait-fails"
+	response.ServiceConfig.MaintenanceWindow = &database.PostgreSQLMaintenance{DayOfWeek: &dayOfWeek, StartHour: &startHour, StartMinute: &startMinute}
+	s := schemaV0(ctx)
+	plan := testDatabasePlan(t, response, types.MapNull(types.StringType))
+	plan.SetAttribute(ctx, path.Root("uuid"), types.StringUnknown())
+	plan.SetAttribute(ctx, path.Root("wait_for_ready"), true)
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, fwresource.CreateRequest{Config: tfsdk.Config(plan), Plan: plan}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error while waiting for the database")
+	}
+
+	created, ok := fake.Database("wait-fails")
+	if !ok {
+		t.Fatal("expected the database to be created")
+	}
+	var uuid types.String
+	resp.State.GetAttribute(ctx, path.Root("uuid"), &uuid)
+	if uuid.ValueString() != created.Uuid {
+		t.Errorf("uuid in state = %s, want %s", uuid, created.Uuid)
+	}
+}
+
+func TestRedactedRequest(t *testing.T) {
+	request := database.PostgreSQLCreateRequest{
+		Name:              "redacted",
+		ApplicationConfig: database.PostgreSQLApplicationConfig{Password: "veryS3cretPassword"},
+	}
+
+	got := redactedRequest(request)
+	if strings.Contains(got, "veryS3cretPassword") || !strings.Contains(got, `"redacted"`) {
+		t.Errorf("redactedRequest() = %s, want the request without the password", got)
+	}
+	if request.ApplicationConfig.Password != "veryS3cretPassword" {
+		t.Error("expected the request itself to keep the password")
+	}
+}
+
+// newTestClient returns a client for the API at url which, like the provider's,
+// reports error responses as *apiError.
+func newTestClient(t *testing.T, url string) *sys11dbaassdk.Client {
+	t.Helper()
+
+	client, err := sys11dbaassdk.NewClient(
+		url,
+		sys11dbaassdk.WithApiKey("fake"),
+		sys11dbaassdk.WithHTTPClient(&http.Client{Transport: newAPIErrorTransport(http.DefaultTransport)}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
 func TestDatabaseResourceWithNetworkMigration(t *testing.T) {
 	resourceName := acctest.RandomWithPrefix("migrate_network")
 	resource.ParallelTest(t, resource.TestCase{
@@ -331,3 +1507,124 @@ resource "sys11dbaas_database" "test" {
 		},
 	})
 }
+
+func testDatabaseResponse() database.PostgreSQLGetResponse {
+	instances, disksize, retention, hour, minute := int64(1), int64(25), int64(7), int64(3), int64(15)
+	enabled := true
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	return database.PostgreSQLGetResponse{
+		Uuid:           "2c3f4a8e-5d61-4c1a-9e0b-7f1d2a3b4c5d",
+		Name:           "converter",
+		Status:         database.StateReady,
+		Phase:          "Running",
+		ResourceStatus: resourceSynced,
+		CreatedBy:      "someone@example.com",
+		CreatedAt:      &now,
+		LastModifiedBy: "someone@example.com",
+		LastModifiedAt: &now,
+		ServiceConfig: database.PostgreSQLServiceConfig{
+			Disksize: &disksize,
+			Type:     "database",
+			Flavor:   "SCS-2V-4-50n",
+			Region:   "dus2",
+		},
+		ApplicationConfig: database.PostgreSQLApplicationConfig{
+			Type:      "postgresql",
+			Instances: &instances,
+			Version:   "17.4",
+			ScheduledBackups: &database.PostgreSQLBackupSchedule{
+				Retention: &retention,
+				Schedule:  &database.PostgreSQLBackupScheduleConfig{Hour: &hour, Minute: &minute},
+			},
+			PublicNetworking: &database.PostgreSQLPublicNetworking{
+				Enabled:      &enabled,
+				AllowedCidrs: &[]string{"0.0.0.0/0"},
+			},
+		},
+	}
+}
+
+func TestPsqlGetResponseToModelRefreshesApplicationConfig(t *testing.T) {
+	ctx := context.Background()
+
+	// Prior state as written by Create, including the password from the plan.
+	var model DatabaseModel
+	if diags := psqlGetResponseToModel(ctx, testDatabaseResponse(), &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var prior ApplicationConfigModel
+	model.ApplicationConfig.As(ctx, &prior, basetypes.ObjectAsOptions{})
+	prior.Password = types.StringValue("test_test_test_test")
+	prior.Features, _ = types.MapValueFrom(ctx, types.StringType, map[string]string{"example_feature": "off"})
+	model.ApplicationConfig, _ = types.ObjectValueFrom(ctx, prior.AttributeTypes(), prior)
+
+	// The cluster was scaled and upgraded outside of Terraform.
+	response := testDatabaseResponse()
+	instances := int64(3)
+	response.ApplicationConfig.Instances = &instances
+	response.ApplicationConfig.Version = "17.5"
+	response.ApplicationConfig.PublicNetworking = nil
+	response.ApplicationConfig.Features = &map[string]database.PostgreSQLApplicationConfigFeatures{
+		"example_feature": "on",
+		"default_feature": "off",
+	}
+
+	if diags := psqlGetResponseToModel(ctx, response, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var refreshed ApplicationConfigModel
+	if diags := model.ApplicationConfig.As(ctx, &refreshed, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if refreshed.Instances.ValueInt64() != 3 {
+		t.Errorf("instances = %s, want 3", refreshed.Instances)
+	}
+	if refreshed.Version.ValueString() != "17.5" {
+		t.Errorf("version = %s, want 17.5", refreshed.Version)
+	}
+	if refreshed.Password.ValueString() != "test_test_test_test" {
+		t.Errorf("password = %s, want the prior password", refreshed.Password)
+	}
+	if !refreshed.PublicNetworking.IsNull() {
+		t.Errorf("public_networking = %s, want null", refreshed.PublicNetworking)
+	}
+	if len(refreshed.Features.Elements()) != 1 || refreshed.Features.Elements()["example_feature"].String() != `"on"` {
+		t.Errorf("features = %s, want only example_feature on", refreshed.Features)
+	}
+	if len(refreshed.EffectiveFeatures.Elements()) != 2 || refreshed.EffectiveFeatures.Elements()["default_feature"].String() != `"off"` {
+		t.Errorf("effective_features = %s, want example_feature and default_feature", refreshed.EffectiveFeatures)
+	}
+}
+
+func TestPsqlGetResponseToModelWithoutPriorState(t *testing.T) {
+	ctx := context.Background()
+
+	response := testDatabaseResponse()
+	response.ApplicationConfig.ScheduledBackups.Schedule = nil
+	response.ApplicationConfig.PublicNetworking = nil
+
+	model := DatabaseModel{ApplicationConfig: types.ObjectNull(ApplicationConfigModel{}.AttributeTypes())}
+	if diags := psqlGetResponseToModel(ctx, response, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var applicationConfig ApplicationConfigModel
+	model.ApplicationConfig.As(ctx, &applicationConfig, basetypes.ObjectAsOptions{})
+	if !applicationConfig.Password.IsNull() {
+		t.Errorf("password = %s, want null", applicationConfig.Password)
+	}
+
+	var scheduledBackups ScheduledBackupsModel
+	applicationConfig.ScheduledBackups.As(ctx, &scheduledBackups, basetypes.ObjectAsOptions{})
+	if scheduledBackups.Retention.ValueInt64() != 7 || !scheduledBackups.Schedule.IsNull() {
+		t.Errorf("scheduled_backups = %s, want retention 7 without schedule", applicationConfig.ScheduledBackups)
+	}
+
+	var serviceConfig ServiceConfigModel
+	model.ServiceConfig.As(ctx, &serviceConfig, basetypes.ObjectAsOptions{})
+	if !serviceConfig.RemoteIps.IsNull() || !serviceConfig.MaintenanceWindow.IsNull() {
+		t.Errorf("service_config = %s, want null remote_ips and maintenance_window", model.ServiceConfig)
+	}
+}
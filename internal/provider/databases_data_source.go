@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+// databasesDataSourceModel maps the data source schema data.
+type databasesDataSourceModel struct {
+	NameRegex types.String              `tfsdk:"name_regex"`
+	Type      types.String              `tfsdk:"type"`
+	Version   types.String              `tfsdk:"version"`
+	Region    types.String              `tfsdk:"region"`
+	Status    types.String              `tfsdk:"status"`
+	Phase     types.String              `tfsdk:"phase"`
+	Databases []databaseDataSourceModel `tfsdk:"databases"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &DatabasesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &DatabasesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DatabasesDataSource{}
+)
+
+// NewDatabasesDataSource is a helper function to simplify the provider implementation.
+func NewDatabasesDataSource() datasource.DataSource {
+	return &DatabasesDataSource{}
+}
+
+// DatabasesDataSource is the data source implementation.
+type DatabasesDataSource struct {
+	client       *database.TypedClient
+	project      types.String
+	organization types.String
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DatabasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*sys11DBaaSProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sys11DBaaSProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.client.V2()
+	d.organization = providerData.organization
+	d.project = providerData.project
+}
+
+// Metadata returns the data source type name.
+func (d *DatabasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_databases"
+}
+
+// Schema defines the schema for the data source.
+func (d *DatabasesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes, diags := databaseDataSourceAttributes(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the databases of the configured project, ordered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the name of the listed databases must match.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list databases of this type, e.g. 'postgresql'.",
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Only list databases of this version.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only list databases in this region.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only list databases with this overall status, e.g. 'Ready'.",
+				Optional:    true,
+			},
+			"phase": schema.StringAttribute{
+				Description: "Only list databases in this detailed status.",
+				Optional:    true,
+			},
+			"databases": schema.ListNestedAttribute{
+				Description: "List of databases.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that name_regex is a valid regular expression.
+func (d *DatabasesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateRegexAttribute(ctx, req.Config, path.Root("name_regex"))...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DatabasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.client.ListPostgreSQL(ctx, d.organization.ValueString(), d.project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Databases",
+			"Could not list databases, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	databases, err = filterDatabases(databases, state)
+	if err != nil {
+		resp.Diagnostics.Append(invalidNameRegexDiagnostic(err))
+		return
+	}
+
+	state.Databases = make([]databaseDataSourceModel, 0, len(databases))
+	for _, db := range databases {
+		model, diags := newDatabaseDataSourceModel(ctx, d.organization.ValueString(), d.project.ValueString(), db)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Databases = append(state.Databases, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// filterDatabases returns the databases matching the filters of config,
+// ordered by name and uuid. It fails if name_regex is not a valid regular
+// expression, which validation misses when it is only known at apply time.
+func filterDatabases(databases []database.PostgreSQLGetResponse, config databasesDataSourceModel) ([]database.PostgreSQLGetResponse, error) {
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Unset filters match every value.
+	matches := func(filter types.String, value string) bool {
+		return filter.IsNull() || filter.ValueString() == value
+	}
+
+	filtered := slices.DeleteFunc(slices.Clone(databases), func(db database.PostgreSQLGetResponse) bool {
+		if nameRegex != nil && !nameRegex.MatchString(db.Name) {
+			return true
+		}
+		return !matches(config.Type, db.ApplicationConfig.Type) ||
+			!matches(config.Version, db.ApplicationConfig.Version) ||
+			!matches(config.Region, db.ServiceConfig.Region) ||
+			!matches(config.Status, db.Status) ||
+			!matches(config.Phase, db.Phase)
+	})
+
+	slices.SortFunc(filtered, func(a, b database.PostgreSQLGetResponse) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Uuid, b.Uuid))
+	})
+
+	return filtered, nil
+}
+
+// invalidNameRegexDiagnostic reports a name_regex filterDatabases rejected.
+func invalidNameRegexDiagnostic(err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("name_regex"),
+		"Invalid regular expression",
+		"The name_regex is not a valid regular expression: "+err.Error(),
+	)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-sys11dbaas/internal/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+func TestDatabasesDataSource(t *testing.T) {
+	fake := testhelpers.NewFakeDBaaS(t)
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "team-b-db")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "team-a-db")
+	fake.AddDatabase(testhelpers.FakeOrganization, testhelpers.FakeProject, "reporting")
+	fake.AddDatabase(testhelpers.FakeOrganization, "other-project", "team-c-db")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + `
+data "sys11dbaas_databases" "all" {}
+
+data "sys11dbaas_databases" "teams" {
+  name_regex = "^team-"
+  region     = "dus2"
+  status     = "Ready"
+}
+
+data "sys11dbaas_databases" "none" {
+  version = "16.8"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sys11dbaas_databases.all", "databases.#", "3"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_databases.teams", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_databases.teams", "databases.0.name", "team-a-db"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_databases.teams", "databases.1.name", "team-b-db"),
+					resource.TestCheckResourceAttrSet("data.sys11dbaas_databases.teams", "databases.0.application_config.public_networking.hostname"),
+					resource.TestCheckResourceAttr("data.sys11dbaas_databases.none", "databases.#", "0"),
+				),
+			},
+			{
+				Config:      fake.ProviderConfig() + `data "sys11dbaas_databases" "test" { name_regex = "[" }`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+		},
+	})
+}
+
+func TestFilterDatabases(t *testing.T) {
+	newDatabase := func(uuid, name, version, region, status string) database.PostgreSQLGetResponse {
+		return database.PostgreSQLGetResponse{
+			Uuid:              uuid,
+			Name:              name,
+			Status:            status,
+			Phase:             "Running",
+			ApplicationConfig: database.PostgreSQLApplicationConfig{Type: "postgresql", Version: version},
+			ServiceConfig:     database.PostgreSQLServiceConfig{Region: region},
+		}
+	}
+	databases := []database.PostgreSQLGetResponse{
+		newDatabase("3", "orders", "17.4", "dus2", "Ready"),
+		newDatabase("2", "billing", "16.8", "dus2", "Ready"),
+		newDatabase("1", "orders", "17.4", "ams1", "Creating"),
+	}
+
+	tests := map[string]struct {
+		config databasesDataSourceModel
+		want   []string
+		err    bool
+	}{
+		"ordered by name and uuid": {want: []string{"2", "1", "3"}},
+		"name_regex":               {config: databasesDataSourceModel{NameRegex: types.StringValue("^ord")}, want: []string{"1", "3"}},
+		"version":                  {config: databasesDataSourceModel{Version: types.StringValue("16.8")}, want: []string{"2"}},
+		"region and status":        {config: databasesDataSourceModel{Region: types.StringValue("dus2"), Status: types.StringValue("Ready")}, want: []string{"2", "3"}},
+		"type":                     {config: databasesDataSourceModel{Type: types.StringValue("mysql")}, want: []string{}},
+		"phase":                    {config: databasesDataSourceModel{Phase: types.StringValue("Running")}, want: []string{"2", "1", "3"}},
+		"invalid name_regex":       {config: databasesDataSourceModel{NameRegex: types.StringValue("(")}, err: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := filterDatabases(databases, tt.config)
+			if tt.err {
+				if err == nil {
+					t.Fatal("filterDatabases() returned no error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("filterDatabases() returned %d databases, want %v", len(got), tt.want)
+			}
+			for i, db := range got {
+				if db.Uuid != tt.want[i] {
+					t.Errorf("database %d = %s, want %s", i, db.Uuid, tt.want[i])
+				}
+			}
+		})
+	}
+}
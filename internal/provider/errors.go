@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodySize limits how much of an error response is kept in an
+// apiError.
+const maxErrorBodySize = 4096
+
+// apiError is returned for requests the API answered with an error status.
+// The SDK does not expose the status code of its own errors, so the client
+// transport returns it in their place.
+type apiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	if e.Body == "" {
+		return "API responded with " + e.Status
+	}
+
+	return fmt.Sprintf("API responded with %s: %s", e.Status, e.Body)
+}
+
+// apiErrorTransport turns responses with an error status into an *apiError.
+type apiErrorTransport struct {
+	next http.RoundTripper
+}
+
+func newAPIErrorTransport(next http.RoundTripper) *apiErrorTransport {
+	return &apiErrorTransport{next: next}
+}
+
+func (t *apiErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	return nil, &apiError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       strings.TrimSpace(string(body)),
+	}
+}
+
+// isNotFound reports whether err is the API's answer to a request for an
+// object that does not exist.
+func isNotFound(err error) bool {
+	var apiErr *apiError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.Error(w, `{"detail": "database not found"}`, http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newAPIErrorTransport(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/found")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	_, err = client.Get(server.URL + "/missing")
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *apiError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Body != `{"detail": "database not found"}` {
+		t.Errorf("unexpected status %d or body %q", apiErr.StatusCode, apiErr.Body)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":           {err: nil, want: false},
+		"plain error":   {err: errors.New("not found"), want: false},
+		"not found":     {err: &apiError{StatusCode: http.StatusNotFound}, want: true},
+		"wrapped":       {err: fmt.Errorf("get database: %w", &apiError{StatusCode: http.StatusNotFound}), want: true},
+		"other status":  {err: &apiError{StatusCode: http.StatusInternalServerError}, want: false},
+		"wrapped other": {err: fmt.Errorf("get database: %w", &apiError{StatusCode: http.StatusForbidden}), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isNotFound(tt.err); got != tt.want {
+				t.Errorf("isNotFound(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -39,9 +39,7 @@ func NewFeaturesDataSource() datasource.DataSource {
 
 // FeaturesDataSource is the data source implementation.
 type FeaturesDataSource struct {
-	client       *v2.TypedClient
-	project      types.String
-	organization types.String
+	catalogs *catalogCache
 }
 
 // Configure adds the provider configured client to the data source.
@@ -60,9 +58,7 @@ func (d *FeaturesDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = providerData.client.V2()
-	d.organization = providerData.organization
-	d.project = providerData.project
+	d.catalogs = providerData.catalogs
 }
 
 // Metadata returns the data source type name.
@@ -105,7 +101,7 @@ func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadReques
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
 	if state.Type == "postgresql" {
-		features, err := d.client.ListFeatures(ctx, d.organization.ValueString(), d.project.ValueString())
+		features, err := d.catalogs.Features(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read features",
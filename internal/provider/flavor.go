@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"cmp"
+	"regexp"
+	"strconv"
+)
+
+// scsFlavorName matches flavor names of the SCS flavor naming standard, like
+// SCS-2V-4-50n: 2 vCPUs, 4 GiB RAM and a 50 GB network disk. Disks may be
+// given as a multiple, like 2x50s, and extensions after an underscore are
+// ignored.
+var scsFlavorName = regexp.MustCompile(`^SCS-(\d+)[LVTC]i?-(\d+(?:\.\d+)?)u?o?(?:-(?:(\d+)x)?(\d+)([nhsp])?)?(?:_.*)?$`)
+
+// scsDiskTypes maps the disk suffixes of SCS flavor names to disk types.
+var scsDiskTypes = map[string]string{
+	"n": "network",
+	"h": "local-hdd",
+	"s": "local-ssd",
+	"p": "local-nvme",
+}
+
+// flavorCapacity is the size of a flavor as encoded in its name. diskGB is
+// zero and diskType empty for flavors without a disk, diskType is also empty
+// if the name does not specify it.
+type flavorCapacity struct {
+	vcpus    int64
+	ramGB    float64
+	diskGB   int64
+	diskType string
+}
+
+// parseFlavorName returns the capacity encoded in an SCS flavor name. It
+// reports false for names that do not follow the standard.
+func parseFlavorName(name string) (flavorCapacity, bool) {
+	match := scsFlavorName.FindStringSubmatch(name)
+	if match == nil {
+		return flavorCapacity{}, false
+	}
+
+	var capacity flavorCapacity
+	capacity.vcpus, _ = strconv.ParseInt(match[1], 10, 64)
+	capacity.ramGB, _ = strconv.ParseFloat(match[2], 64)
+	if match[4] != "" {
+		capacity.diskGB, _ = strconv.ParseInt(match[4], 10, 64)
+		if match[3] != "" {
+			disks, _ := strconv.ParseInt(match[3], 10, 64)
+			capacity.diskGB *= disks
+		}
+		capacity.diskType = scsDiskTypes[match[5]]
+	}
+
+	return capacity, true
+}
+
+// compareCapacity orders capacities by vCPUs, then RAM, then disk size.
+func compareCapacity(a, b flavorCapacity) int {
+	return cmp.Or(
+		cmp.Compare(a.vcpus, b.vcpus),
+		cmp.Compare(a.ramGB, b.ramGB),
+		cmp.Compare(a.diskGB, b.diskGB),
+	)
+}
@@ -0,0 +1,29 @@
+package provider
+
+import "testing"
+
+func TestParseFlavorName(t *testing.T) {
+	tests := map[string]struct {
+		want flavorCapacity
+		ok   bool
+	}{
+		"SCS-2V-4-50n":      {want: flavorCapacity{vcpus: 2, ramGB: 4, diskGB: 50, diskType: "network"}, ok: true},
+		"SCS-16V-64-100s":   {want: flavorCapacity{vcpus: 16, ramGB: 64, diskGB: 100, diskType: "local-ssd"}, ok: true},
+		"SCS-1L-0.5-10h":    {want: flavorCapacity{vcpus: 1, ramGB: 0.5, diskGB: 10, diskType: "local-hdd"}, ok: true},
+		"SCS-4Ti-16u-2x50p": {want: flavorCapacity{vcpus: 4, ramGB: 16, diskGB: 100, diskType: "local-nvme"}, ok: true},
+		"SCS-2C-8-20":       {want: flavorCapacity{vcpus: 2, ramGB: 8, diskGB: 20}, ok: true},
+		"SCS-2V-4":          {want: flavorCapacity{vcpus: 2, ramGB: 4}, ok: true},
+		"SCS-2V-4-50n_GNa":  {want: flavorCapacity{vcpus: 2, ramGB: 4, diskGB: 50, diskType: "network"}, ok: true},
+		"m1.small":          {ok: false},
+		"SCS-2X-4-50n":      {ok: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := parseFlavorName(name)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("parseFlavorName(%q) = %+v, %v, want %+v, %v", name, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	defaultPasswordLength = 32
+	minPasswordLength     = 16
+)
+
+// Character classes of generated passwords. Special characters are limited to
+// the ones that need no percent-encoding in the userinfo of connection URIs.
+// They are not safe to use unquoted in shells.
+const (
+	passwordLower   = "abcdefghijklmnopqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumeric = "0123456789"
+	passwordSpecial = "-_.~!*()"
+)
+
+// passwordPolicy describes the passwords generatePassword creates.
+type passwordPolicy struct {
+	length  int64
+	lower   bool
+	upper   bool
+	numeric bool
+	special bool
+}
+
+// defaultPasswordPolicy is used when no password_policy is configured.
+var defaultPasswordPolicy = passwordPolicy{
+	length:  defaultPasswordLength,
+	lower:   true,
+	upper:   true,
+	numeric: true,
+	special: true,
+}
+
+// newPasswordPolicy converts the configured policy, falling back to the
+// defaults for unset values.
+func newPasswordPolicy(model PasswordPolicyModel) passwordPolicy {
+	policy := defaultPasswordPolicy
+	if !model.Length.IsNull() && !model.Length.IsUnknown() {
+		policy.length = model.Length.ValueInt64()
+	}
+	for _, class := range []struct {
+		value  types.Bool
+		target *bool
+	}{
+		{model.Lower, &policy.lower},
+		{model.Upper, &policy.upper},
+		{model.Numeric, &policy.numeric},
+		{model.Special, &policy.special},
+	} {
+		if !class.value.IsNull() && !class.value.IsUnknown() {
+			*class.target = class.value.ValueBool()
+		}
+	}
+
+	return policy
+}
+
+// classes returns the character classes enabled by the policy.
+func (p passwordPolicy) classes() []string {
+	var classes []string
+	if p.lower {
+		classes = append(classes, passwordLower)
+	}
+	if p.upper {
+		classes = append(classes, passwordUpper)
+	}
+	if p.numeric {
+		classes = append(classes, passwordNumeric)
+	}
+	if p.special {
+		classes = append(classes, passwordSpecial)
+	}
+
+	return classes
+}
+
+// generatePassword returns a cryptographically random password following the
+// policy, with at least one character of every enabled class.
+func generatePassword(policy passwordPolicy) (string, error) {
+	classes := policy.classes()
+	if len(classes) == 0 {
+		return "", errors.New("at least one character class must be enabled")
+	}
+	if policy.length < minPasswordLength || policy.length < int64(len(classes)) {
+		return "", errors.New("password is too short")
+	}
+
+	var all string
+	password := make([]byte, 0, policy.length)
+	for _, class := range classes {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+		all += class
+	}
+	for int64(len(password)) < policy.length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Shuffle, so the guaranteed characters are not always in front.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+// generateApplicationPassword generates a password following the
+// password_policy of the application config.
+func generateApplicationPassword(ctx context.Context, applicationConfig ApplicationConfigModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var policy PasswordPolicyModel
+	if !applicationConfig.PasswordPolicy.IsNull() && !applicationConfig.PasswordPolicy.IsUnknown() {
+		diags.Append(applicationConfig.PasswordPolicy.As(ctx, &policy, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return "", diags
+		}
+	}
+
+	password, err := generatePassword(newPasswordPolicy(policy))
+	if err != nil {
+		diags.AddAttributeError(
+			passwordPolicyPath,
+			"Error generating password",
+			"Could not generate a password for the database, unexpected error: "+err.Error(),
+		)
+	}
+
+	return password, diags
+}
+
+// randomChar returns a uniformly random character of chars.
+func randomChar(chars string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+
+	return chars[i.Int64()], nil
+}
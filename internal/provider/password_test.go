@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	tests := map[string]struct {
+		policy passwordPolicy
+		err    bool
+	}{
+		"default":      {policy: defaultPasswordPolicy},
+		"minimum":      {policy: passwordPolicy{length: minPasswordLength, lower: true, upper: true, numeric: true, special: true}},
+		"digits only":  {policy: passwordPolicy{length: 20, numeric: true}},
+		"no specials":  {policy: passwordPolicy{length: 64, lower: true, upper: true, numeric: true}},
+		"too short":    {policy: passwordPolicy{length: minPasswordLength - 1, lower: true}, err: true},
+		"no character": {policy: passwordPolicy{length: 32}, err: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			password, err := generatePassword(tt.policy)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("generatePassword() = %q, want an error", password)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if int64(len(password)) != tt.policy.length {
+				t.Errorf("password %q has length %d, want %d", password, len(password), tt.policy.length)
+			}
+			classes := tt.policy.classes()
+			for _, class := range classes {
+				if !strings.ContainsAny(password, class) {
+					t.Errorf("password %q has no character of %q", password, class)
+				}
+			}
+			for _, c := range password {
+				if !strings.ContainsRune(strings.Join(classes, ""), c) {
+					t.Errorf("password %q contains %q, which is in no enabled class", password, c)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePasswordIsRandom(t *testing.T) {
+	first, err := generatePassword(defaultPasswordPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := generatePassword(defaultPasswordPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first == second {
+		t.Errorf("generated the same password twice: %q", first)
+	}
+}
+
+func TestNewPasswordPolicy(t *testing.T) {
+	if got := newPasswordPolicy(PasswordPolicyModel{}); got != defaultPasswordPolicy {
+		t.Errorf("newPasswordPolicy() of an empty model = %+v, want the defaults", got)
+	}
+
+	got := newPasswordPolicy(PasswordPolicyModel{
+		Length:  types.Int64Value(20),
+		Special: types.BoolValue(false),
+		Upper:   types.BoolUnknown(),
+	})
+	want := passwordPolicy{length: 20, lower: true, upper: true, numeric: true}
+	if got != want {
+		t.Errorf("newPasswordPolicy() = %+v, want %+v", got, want)
+	}
+}
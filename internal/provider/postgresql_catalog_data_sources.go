@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v2 "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+// NewPostgresqlFlavorsDataSource is a helper function to simplify the provider implementation.
+func NewPostgresqlFlavorsDataSource() datasource.DataSource {
+	return &catalogDataSource[v2.PostgreSQLFlavor]{
+		typeName:  "postgresql_flavors",
+		attribute: "flavors",
+		kind:      "flavor",
+		engine:    "PostgreSQL",
+		sized:     true,
+		list: func(ctx context.Context, catalogs *catalogCache) ([]v2.PostgreSQLFlavor, error) {
+			return catalogs.PostgreSQLFlavors(ctx)
+		},
+		entry: func(flavor v2.PostgreSQLFlavor) catalogEntryModel {
+			return catalogEntryModel{
+				ID:          types.StringValue(flavor.Id),
+				Description: types.StringValue(flavor.Description),
+				Default:     types.BoolValue(flavor.Default),
+			}
+		},
+	}
+}
+
+// NewPostgresqlRegionsDataSource is a helper function to simplify the provider implementation.
+func NewPostgresqlRegionsDataSource() datasource.DataSource {
+	return &catalogDataSource[v2.PostgreSQLRegion]{
+		typeName:  "postgresql_regions",
+		attribute: "regions",
+		kind:      "region",
+		engine:    "PostgreSQL",
+		list: func(ctx context.Context, catalogs *catalogCache) ([]v2.PostgreSQLRegion, error) {
+			return catalogs.PostgreSQLRegions(ctx)
+		},
+		entry: func(region v2.PostgreSQLRegion) catalogEntryModel {
+			return catalogEntryModel{
+				ID:          types.StringValue(region.Id),
+				Description: types.StringValue(region.Description),
+				Default:     types.BoolValue(region.Default),
+			}
+		},
+	}
+}
+
+// NewPostgresqlVersionsDataSource is a helper function to simplify the provider implementation.
+func NewPostgresqlVersionsDataSource() datasource.DataSource {
+	return &catalogDataSource[v2.PostgreSQLVersion]{
+		typeName:  "postgresql_versions",
+		attribute: "versions",
+		kind:      "version",
+		engine:    "PostgreSQL",
+		versioned: true,
+		list: func(ctx context.Context, catalogs *catalogCache) ([]v2.PostgreSQLVersion, error) {
+			return catalogs.PostgreSQLVersions(ctx)
+		},
+		entry: func(version v2.PostgreSQLVersion) catalogEntryModel {
+			return catalogEntryModel{
+				ID:          types.StringValue(version.Id),
+				Description: types.StringValue(version.Description),
+				Default:     types.BoolValue(version.Default),
+			}
+		},
+	}
+}
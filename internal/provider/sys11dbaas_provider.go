@@ -5,22 +5,31 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"strconv"
 
 	sys11dbaassdk "github.com/syseleven/sys11dbaas-sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure Sys11DBaaSProvider satisfies various provider interfaces.
-var _ provider.Provider = &Sys11DBaaSProvider{}
+var (
+	_ provider.Provider                  = &Sys11DBaaSProvider{}
+	_ provider.ProviderWithListResources = &Sys11DBaaSProvider{}
+)
 
 // Sys11DBaaSProvider defines the provider implementation.
 type Sys11DBaaSProvider struct {
@@ -32,18 +41,40 @@ type Sys11DBaaSProvider struct {
 
 // Sys11DBaaSProvider maps provider schema data to a Go type.
 type Sys11DBaaSProviderModel struct {
-	URL             types.String `tfsdk:"url"`
-	ApiKey          types.String `tfsdk:"api_key"`
-	Project         types.String `tfsdk:"project"`
-	Organization    types.String `tfsdk:"organization"`
-	WaitForCreation types.Bool   `tfsdk:"wait_for_creation"`
+	URL                  types.String  `tfsdk:"url"`
+	ApiKey               types.String  `tfsdk:"api_key"`
+	Project              types.String  `tfsdk:"project"`
+	Organization         types.String  `tfsdk:"organization"`
+	WaitForCreation      types.Bool    `tfsdk:"wait_for_creation"`
+	WaitForDeletion      types.Bool    `tfsdk:"wait_for_deletion"`
+	WaitForReadyOnRead   types.Bool    `tfsdk:"wait_for_ready_on_read"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	Polling              *PollingModel `tfsdk:"polling"`
+	Retry                *RetryModel   `tfsdk:"retry"`
+}
+
+// PollingModel maps the polling block of the provider configuration.
+type PollingModel struct {
+	InitialInterval timetypes.GoDuration `tfsdk:"initial_interval"`
+	MaxInterval     timetypes.GoDuration `tfsdk:"max_interval"`
+	Multiplier      types.Float64        `tfsdk:"multiplier"`
+}
+
+// RetryModel maps the retry block of the provider configuration.
+type RetryModel struct {
+	MaxAttempts    types.Int64          `tfsdk:"max_attempts"`
+	MaxElapsedTime timetypes.GoDuration `tfsdk:"max_elapsed_time"`
 }
 
 type sys11DBaaSProviderData struct {
-	client          *sys11dbaassdk.Client
-	project         types.String `tfsdk:"project"`
-	organization    types.String `tfsdk:"organization"`
-	waitForCreation types.Bool   `tfsdk:"wait_for_creation"`
+	client             *sys11dbaassdk.Client
+	project            types.String `tfsdk:"project"`
+	organization       types.String `tfsdk:"organization"`
+	waitForCreation    types.Bool   `tfsdk:"wait_for_creation"`
+	waitForDeletion    types.Bool   `tfsdk:"wait_for_deletion"`
+	waitForReadyOnRead types.Bool   `tfsdk:"wait_for_ready_on_read"`
+	polling            pollingConfig
+	catalogs           *catalogCache
 }
 
 func (p *Sys11DBaaSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -80,6 +111,65 @@ func (p *Sys11DBaaSProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "Whether to wait for the service to be created. If omitted, the `SYS11DBAAS_WAIT_FOR_CREATION` environment variable is used. Defaults to true",
 			},
+			"wait_for_deletion": schema.BoolAttribute{
+				Required:    false,
+				Optional:    true,
+				Description: "Whether to wait until the service is gone after deleting it. If omitted, the `SYS11DBAAS_WAIT_FOR_DELETION` environment variable is used. Defaults to true",
+			},
+			"wait_for_ready_on_read": schema.BoolAttribute{
+				Required:    false,
+				Optional:    true,
+				Description: "Whether reading a database waits until it is ready and synced. Otherwise the current state is read and a warning is shown for databases that are not ready. If omitted, the `SYS11DBAAS_WAIT_FOR_READY_ON_READ` environment variable is used. Defaults to false",
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Required:    false,
+				Optional:    true,
+				Description: "Maximum number of API requests per second, shared by all resources and data sources of this provider. If omitted, the `SYS11DBAAS_MAX_REQUESTS_PER_SECOND` environment variable is used. Defaults to `0`, which means no limit",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"polling": schema.SingleNestedBlock{
+				Description: "Controls how often the API is polled while waiting for a database to become ready. The interval grows exponentially from `initial_interval` up to `max_interval` and is randomly jittered.",
+				Attributes: map[string]schema.Attribute{
+					"initial_interval": schema.StringAttribute{
+						CustomType:  timetypes.GoDurationType{},
+						Optional:    true,
+						Description: "Interval after the first poll, e.g. `5s`. Defaults to `5s`.",
+					},
+					"max_interval": schema.StringAttribute{
+						CustomType:  timetypes.GoDurationType{},
+						Optional:    true,
+						Description: "Upper bound for the interval between two polls, e.g. `1m`. Defaults to `30s`.",
+					},
+					"multiplier": schema.Float64Attribute{
+						Optional:    true,
+						Description: "Factor by which the interval grows after every poll. Defaults to `2`.",
+						Validators: []validator.Float64{
+							float64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"retry": schema.SingleNestedBlock{
+				Description: "Controls how API requests that failed with a transient error, like a rate limit, a bad gateway or a connection reset, are retried. A `Retry-After` header sent by the API is honored. Requests creating a database are only retried if they did not reach the API.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Maximum number of attempts per request, including the first one. Set to `1` to disable retries. Defaults to `5`.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_elapsed_time": schema.StringAttribute{
+						CustomType:  timetypes.GoDurationType{},
+						Optional:    true,
+						Description: "Time after which a request is not retried anymore, e.g. `5m`. Defaults to `2m`.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -157,6 +247,17 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 		waitForCreation, _ = strconv.ParseBool(waitForCreationEnv)
 	}
 
+	var waitForDeletion bool
+	if waitForDeletionEnv, set := os.LookupEnv("SYS11DBAAS_WAIT_FOR_DELETION"); !set {
+		waitForDeletion = true
+	} else {
+		waitForDeletion, _ = strconv.ParseBool(waitForDeletionEnv)
+	}
+
+	waitForReadyOnRead, _ := strconv.ParseBool(os.Getenv("SYS11DBAAS_WAIT_FOR_READY_ON_READ"))
+
+	maxRequestsPerSecond, _ := strconv.ParseFloat(os.Getenv("SYS11DBAAS_MAX_REQUESTS_PER_SECOND"), 64)
+
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
 	}
@@ -177,6 +278,50 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 		waitForCreation = config.WaitForCreation.ValueBool()
 	}
 
+	if !config.WaitForDeletion.IsNull() {
+		waitForDeletion = config.WaitForDeletion.ValueBool()
+	}
+
+	if !config.WaitForReadyOnRead.IsNull() {
+		waitForReadyOnRead = config.WaitForReadyOnRead.ValueBool()
+	}
+
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = config.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	polling := defaultPollingConfig()
+	if config.Polling != nil {
+		if !config.Polling.InitialInterval.IsNull() {
+			interval, diags := config.Polling.InitialInterval.ValueGoDuration()
+			resp.Diagnostics.Append(diags...)
+			polling.initialInterval = interval
+		}
+
+		if !config.Polling.MaxInterval.IsNull() {
+			interval, diags := config.Polling.MaxInterval.ValueGoDuration()
+			resp.Diagnostics.Append(diags...)
+			polling.maxInterval = interval
+		}
+
+		if !config.Polling.Multiplier.IsNull() {
+			polling.multiplier = config.Polling.Multiplier.ValueFloat64()
+		}
+	}
+
+	retry := defaultRetryConfig()
+	if config.Retry != nil {
+		if !config.Retry.MaxAttempts.IsNull() {
+			retry.maxAttempts = int(config.Retry.MaxAttempts.ValueInt64())
+		}
+
+		if !config.Retry.MaxElapsedTime.IsNull() {
+			elapsed, diags := config.Retry.MaxElapsedTime.ValueGoDuration()
+			resp.Diagnostics.Append(diags...)
+			retry.maxElapsedTime = elapsed
+		}
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -220,6 +365,30 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if polling.initialInterval <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("polling").AtName("initial_interval"),
+			"Invalid polling interval",
+			"The initial polling interval must be greater than zero.",
+		)
+	}
+
+	if polling.maxInterval < polling.initialInterval {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("polling").AtName("max_interval"),
+			"Invalid polling interval",
+			"The maximum polling interval must not be smaller than the initial polling interval ("+polling.initialInterval.String()+").",
+		)
+	}
+
+	if retry.maxElapsedTime < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry").AtName("max_elapsed_time"),
+			"Invalid retry time",
+			"The maximum elapsed time for retries must not be negative.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,6 +398,13 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 	ctx = tflog.SetField(ctx, "sys11dbaas_organization", organization)
 	ctx = tflog.SetField(ctx, "sys11dbaas_project", project)
 	ctx = tflog.SetField(ctx, "sys11dbaas_wait_for_creation", waitForCreation)
+	ctx = tflog.SetField(ctx, "sys11dbaas_wait_for_deletion", waitForDeletion)
+	ctx = tflog.SetField(ctx, "sys11dbaas_wait_for_ready_on_read", waitForReadyOnRead)
+	ctx = tflog.SetField(ctx, "sys11dbaas_max_requests_per_second", maxRequestsPerSecond)
+	ctx = tflog.SetField(ctx, "sys11dbaas_polling_initial_interval", polling.initialInterval.String())
+	ctx = tflog.SetField(ctx, "sys11dbaas_polling_max_interval", polling.maxInterval.String())
+	ctx = tflog.SetField(ctx, "sys11dbaas_retry_max_attempts", retry.maxAttempts)
+	ctx = tflog.SetField(ctx, "sys11dbaas_retry_max_elapsed_time", retry.maxElapsedTime.String())
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "sys11dbaas_api_key")
 
 	tflog.Debug(ctx, "Creating Sys11DBaaS client")
@@ -236,7 +412,21 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 	agent := "sys11dbaas-terraform/" + p.version
 
 	// Create a new Sys11DBaaS client using the configuration values
-	client, err := sys11dbaassdk.NewClient(url, sys11dbaassdk.WithApiKey(apikey), sys11dbaassdk.WithUserAgent(agent))
+	// Every retry passes the rate limit again. Error responses become an
+	// *apiError only after the retry transport has seen their status codes.
+	transport := http.DefaultTransport
+	if maxRequestsPerSecond > 0 {
+		transport = newRateLimitTransport(transport, maxRequestsPerSecond)
+	}
+	httpClient := &http.Client{
+		Transport: newAPIErrorTransport(newRetryTransport(transport, retry)),
+	}
+	client, err := sys11dbaassdk.NewClient(
+		url,
+		sys11dbaassdk.WithApiKey(apikey),
+		sys11dbaassdk.WithUserAgent(agent),
+		sys11dbaassdk.WithHTTPClient(httpClient),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Sys11DBaaS API Client",
@@ -247,20 +437,32 @@ func (p *Sys11DBaaSProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	// Data sources and resources share the catalogs, so each is fetched once.
+	catalogs := newCatalogCache(client.V2(), organization, project, defaultCatalogCacheTTL)
+
 	// Make the Sys11DBaaS client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = &sys11DBaaSProviderData{
-		client:          client,
-		project:         types.StringValue(project),
-		organization:    types.StringValue(organization),
-		waitForCreation: types.BoolValue(waitForCreation),
+		client:             client,
+		project:            types.StringValue(project),
+		organization:       types.StringValue(organization),
+		waitForCreation:    types.BoolValue(waitForCreation),
+		waitForDeletion:    types.BoolValue(waitForDeletion),
+		waitForReadyOnRead: types.BoolValue(waitForReadyOnRead),
+		polling:            polling,
+		catalogs:           catalogs,
 	}
 	resp.ResourceData = &sys11DBaaSProviderData{
-		client:          client,
-		project:         types.StringValue(project),
-		organization:    types.StringValue(organization),
-		waitForCreation: types.BoolValue(waitForCreation),
+		client:             client,
+		project:            types.StringValue(project),
+		organization:       types.StringValue(organization),
+		waitForCreation:    types.BoolValue(waitForCreation),
+		waitForDeletion:    types.BoolValue(waitForDeletion),
+		waitForReadyOnRead: types.BoolValue(waitForReadyOnRead),
+		polling:            polling,
+		catalogs:           catalogs,
 	}
+	resp.ListResourceData = resp.ResourceData
 
 	tflog.Info(ctx, "Configured Sys11DBaaS client", map[string]any{"success": true})
 }
@@ -271,12 +473,20 @@ func (p *Sys11DBaaSProvider) Resources(ctx context.Context) []func() resource.Re
 	}
 }
 
+func (p *Sys11DBaaSProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDatabaseListResource,
+	}
+}
+
 func (p *Sys11DBaaSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewPostgresqlFlavorsDataSource,
 		NewPostgresqlRegionsDataSource,
 		NewPostgresqlVersionsDataSource,
 		NewFeaturesDataSource,
+		NewDatabaseDataSource,
+		NewDatabasesDataSource,
 	}
 }
 
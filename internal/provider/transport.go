@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultRetryMaxAttempts    = 5
+	defaultRetryMaxElapsedTime = 2 * time.Minute
+	defaultRetryInitialBackoff = 1 * time.Second
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// retryConfig controls how often a failed API request is retried before the
+// error is returned to the caller.
+type retryConfig struct {
+	maxAttempts    int
+	maxElapsedTime time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func defaultRetryConfig() retryConfig {
+	return retryConfig{
+		maxAttempts:    defaultRetryMaxAttempts,
+		maxElapsedTime: defaultRetryMaxElapsedTime,
+		initialBackoff: defaultRetryInitialBackoff,
+		maxBackoff:     defaultRetryMaxBackoff,
+	}
+}
+
+// retryTransport retries requests that failed with a transient error, i.e. a
+// connection error or one of the status codes in retryableStatusCodes.
+// Requests that are not idempotent, like creating a database, are only
+// retried if they failed before anything was sent to the API.
+type retryTransport struct {
+	next   http.RoundTripper
+	config retryConfig
+}
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+func newRetryTransport(next http.RoundTripper, config retryConfig) *retryTransport {
+	return &retryTransport{next: next, config: config}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	backoff := t.config.initialBackoff
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			attemptReq = req.Clone(req.Context())
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		var sent atomic.Bool
+		attemptReq = attemptReq.WithContext(withSentTrace(attemptReq.Context(), &sent))
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.config.maxAttempts || !t.shouldRetry(req, resp, err, sent.Load()) {
+			return resp, err
+		}
+
+		wait, ok := retryAfter(resp)
+		if !ok {
+			wait = withJitter(backoff)
+			backoff = min(backoff*2, t.config.maxBackoff)
+		}
+		if time.Since(start)+wait > t.config.maxElapsedTime {
+			return resp, err
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rateLimitTransport delays requests so that all resources and data sources
+// sharing the client stay within the limit together.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+// newRateLimitTransport limits requests to requestsPerSecond, allowing bursts
+// of up to one second worth of requests.
+func newRateLimitTransport(next http.RoundTripper, requestsPerSecond float64) *rateLimitTransport {
+	burst := max(int(requestsPerSecond), 1)
+	return &rateLimitTransport{next: next, limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst)}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// shouldRetry reports whether a request may be sent again after it failed
+// with err or resp.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error, sent bool) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req.Method) || !sent
+	}
+
+	return retryableStatusCodes[resp.StatusCode] && isIdempotent(req.Method)
+}
+
+// withSentTrace returns a context that sets sent, as soon as the request
+// headers are written to the connection. Until then the API cannot have seen
+// the request.
+func withSentTrace(ctx context.Context, sent *atomic.Bool) context.Context {
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		WroteHeaders: func() {
+			sent.Store(true)
+		},
+	})
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp,
+// which is either a number of seconds or a date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
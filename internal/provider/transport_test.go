@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryConfig() retryConfig {
+	return retryConfig{
+		maxAttempts:    3,
+		maxElapsedTime: time.Second,
+		initialBackoff: time.Millisecond,
+		maxBackoff:     time.Millisecond,
+	}
+}
+
+// failingServer answers the first failures requests with status and all
+// further requests with 200.
+func failingServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestRetryTransportRetriesTransientStatus(t *testing.T) {
+	server, requests := failingServer(t, 2, http.StatusServiceUnavailable, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryConfig())}
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"db"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestRetryTransportMaxAttempts(t *testing.T) {
+	server, requests := failingServer(t, 10, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryConfig())}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", resp.StatusCode)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	server, requests := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+	config := testRetryConfig()
+	config.maxElapsedTime = 5 * time.Second
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, config)}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for Retry-After, returned after %s", elapsed)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestRetryTransportMaxElapsedTime(t *testing.T) {
+	server, requests := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"60"}})
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryConfig())}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", resp.StatusCode)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryTransportDoesNotRetrySentPost(t *testing.T) {
+	server, requests := failingServer(t, 1, http.StatusServiceUnavailable, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, testRetryConfig())}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"db"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", resp.StatusCode)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryTransportRetriesUnsentPost(t *testing.T) {
+	server, requests := failingServer(t, 0, http.StatusOK, nil)
+
+	// The first attempt fails to connect, so the request never left the client.
+	var dials atomic.Int32
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if dials.Add(1) == 1 {
+			return nil, &testDialError{}
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: newRetryTransport(next, testRetryConfig())}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"db"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request to reach the server, got %d", got)
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server, requests := failingServer(t, 0, http.StatusOK, nil)
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 20)}
+
+	// The burst of 20 requests passes right away, the next 10 take half a second.
+	start := time.Now()
+	for range 30 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected requests to be limited, 30 requests took %s", elapsed)
+	}
+	if got := requests.Load(); got != 30 {
+		t.Errorf("expected 30 requests, got %d", got)
+	}
+}
+
+func TestRateLimitTransportCancelled(t *testing.T) {
+	server, requests := failingServer(t, 0, http.StatusOK, nil)
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 0.001)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := client.Do(req); err == nil {
+		t.Error("expected the second request to fail while waiting for the limiter")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		"missing":      {header: "", ok: false},
+		"seconds":      {header: "3", want: 3 * time.Second, ok: true},
+		"date in past": {header: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0, ok: true},
+		"invalid":      {header: "soon", ok: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+
+			got, ok := retryAfter(resp)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("retryAfter(%q) = %s, %v, want %s, %v", tt.header, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type testDialError struct{}
+
+func (testDialError) Error() string { return "dial tcp: connection refused" }
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	defaultPollInitialInterval = 5 * time.Second
+	defaultPollMaxInterval     = 30 * time.Second
+	defaultPollMultiplier      = 2.0
+
+	// pollJitter is the fraction by which every interval is randomly
+	// shortened or stretched, so that many resources waiting at the same
+	// time don't hit the API in lockstep.
+	pollJitter = 0.2
+)
+
+// pollingConfig controls how often the provider polls the API while waiting
+// for a database to settle.
+type pollingConfig struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+}
+
+func defaultPollingConfig() pollingConfig {
+	return pollingConfig{
+		initialInterval: defaultPollInitialInterval,
+		maxInterval:     defaultPollMaxInterval,
+		multiplier:      defaultPollMultiplier,
+	}
+}
+
+// poll calls check until it reports done, returns an error or ctx is done.
+// The pause between two calls starts at initialInterval and grows
+// exponentially up to maxInterval.
+func (c pollingConfig) poll(ctx context.Context, check func(ctx context.Context) (bool, error)) error {
+	interval := c.initialInterval
+	for {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(withJitter(interval))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*c.multiplier), c.maxInterval)
+	}
+}
+
+func withJitter(interval time.Duration) time.Duration {
+	return time.Duration(float64(interval) * (1 + pollJitter*(2*rand.Float64()-1)))
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPollingConfigPoll(t *testing.T) {
+	polling := pollingConfig{
+		initialInterval: time.Millisecond,
+		maxInterval:     4 * time.Millisecond,
+		multiplier:      2,
+	}
+
+	calls := 0
+	err := polling.poll(context.Background(), func(context.Context) (bool, error) {
+		calls++
+		return calls == 5, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 5 {
+		t.Fatalf("expected 5 polls, got %d", calls)
+	}
+}
+
+func TestPollingConfigPollError(t *testing.T) {
+	polling := defaultPollingConfig()
+	expected := errors.New("boom")
+
+	err := polling.poll(context.Background(), func(context.Context) (bool, error) {
+		return false, expected
+	})
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %q, got %v", expected, err)
+	}
+}
+
+func TestPollingConfigPollCancelled(t *testing.T) {
+	polling := defaultPollingConfig()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := polling.poll(ctx, func(context.Context) (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	interval := 10 * time.Second
+	for range 100 {
+		d := withJitter(interval)
+		if d < 8*time.Second || d > 12*time.Second {
+			t.Fatalf("jittered interval %s out of bounds", d)
+		}
+	}
+}
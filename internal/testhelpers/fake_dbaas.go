@@ -0,0 +1,530 @@
+package testhelpers
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	database "github.com/syseleven/sys11dbaas-sdk/database/v2"
+)
+
+const (
+	FakeOrganization = "fake-organization"
+	FakeProject      = "fake-project"
+	FakeUser         = "fake-user@example.com"
+
+	// Transitional states reported by FakeDBaaS before a database settles on
+	// database.StateReady and "Synced".
+	FakeStateCreating = "Creating"
+	FakeStateUpdating = "Updating"
+	FakeStateDeleting = "Deleting"
+	FakePhaseRunning  = "Running"
+	FakeResourceBusy  = "Syncing"
+	FakeResourceSync  = "Synced"
+)
+
+// CatalogEntry is a single entry served by the flavors, regions and versions
+// endpoints of FakeDBaaS.
+type CatalogEntry struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
+}
+
+// FakeDBaaS is an in-memory stand-in for the v2 DBaaS API. It implements the
+// endpoints used by the provider, so acceptance tests can point the provider's
+// url at it and run without network access.
+type FakeDBaaS struct {
+	Flavors  []CatalogEntry
+	Regions  []CatalogEntry
+	Versions []CatalogEntry
+	Features []database.Feature
+
+	server          *httptest.Server
+	mu              sync.Mutex
+	databases       map[string]*fakeDatabase
+	pollsUntilReady int
+}
+
+type fakeDatabase struct {
+	organization string
+	project      string
+	response     database.PostgreSQLGetResponse
+	password     string
+	lastUpdate   *database.PostgreSQLCreateRequest
+	pendingPolls int
+	deleting     bool
+}
+
+// NewFakeDBaaS starts a FakeDBaaS that is shut down when the test finishes.
+func NewFakeDBaaS(t testing.TB) *FakeDBaaS {
+	f := &FakeDBaaS{
+		pollsUntilReady: 1,
+		Flavors: []CatalogEntry{
+			{ID: "SCS-2V-4-50n", Description: "2/4/50", Default: true},
+			{ID: "SCS-4V-8-50n", Description: "4/8/50"},
+		},
+		Regions: []CatalogEntry{
+			{ID: "dus2"},
+		},
+		Versions: []CatalogEntry{
+			{ID: "15.6"},
+			{ID: "16.8"},
+			{ID: "17.4", Default: true},
+		},
+		Features:  []database.Feature{},
+		databases: map[string]*fakeDatabase{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /{organization}/{project}/v2/databases", f.createDatabase)
+	mux.HandleFunc("GET /{organization}/{project}/v2/databases", f.listDatabases)
+	mux.HandleFunc("GET /{organization}/{project}/v2/databases/{uuid}", f.getDatabase)
+	mux.HandleFunc("PUT /{organization}/{project}/v2/databases/{uuid}", f.updateDatabase)
+	mux.HandleFunc("DELETE /{organization}/{project}/v2/databases/{uuid}", f.deleteDatabase)
+	mux.HandleFunc("GET /{organization}/{project}/v2/postgresql/flavors", f.listCatalog(func() []CatalogEntry { return f.Flavors }))
+	mux.HandleFunc("GET /{organization}/{project}/v2/postgresql/regions", f.listCatalog(func() []CatalogEntry { return f.Regions }))
+	mux.HandleFunc("GET /{organization}/{project}/v2/postgresql/versions", f.listCatalog(func() []CatalogEntry { return f.Versions }))
+	mux.HandleFunc("GET /{organization}/{project}/v2/features", f.listFeatures)
+
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+// SetPollsUntilReady sets the number of reads a database reports a
+// transitional state after it has been created, updated or deleted.
+func (f *FakeDBaaS) SetPollsUntilReady(polls int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pollsUntilReady = polls
+}
+
+// URL returns the base URL to configure as the provider's url.
+func (f *FakeDBaaS) URL() string {
+	return f.server.URL
+}
+
+// ProviderConfig returns a provider block pointing at the fake API. It polls
+// with short intervals, as the fake settles after a few reads. Each of
+// attributes is added to the block as a line of its own.
+func (f *FakeDBaaS) ProviderConfig(attributes ...string) string {
+	var extra string
+	for _, attribute := range attributes {
+		extra += "  " + attribute + "\n"
+	}
+
+	return fmt.Sprintf(`
+provider "sys11dbaas" {
+  url          = %q
+  api_key      = "fake"
+  organization = %q
+  project      = %q
+%s
+  polling {
+    initial_interval = "10ms"
+    max_interval     = "100ms"
+  }
+}
+`, f.URL(), FakeOrganization, FakeProject, extra)
+}
+
+// Database returns the current API representation of the database with the
+// given name.
+func (f *FakeDBaaS) Database(name string) (database.PostgreSQLGetResponse, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, db := range f.databases {
+		if db.response.Name == name {
+			return db.response, true
+		}
+	}
+
+	return database.PostgreSQLGetResponse{}, false
+}
+
+// Password returns the admin password last sent for the database with the
+// given name, which the API never returns.
+func (f *FakeDBaaS) Password(name string) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, db := range f.databases {
+		if db.response.Name == name {
+			return db.password, true
+		}
+	}
+
+	return "", false
+}
+
+// LastUpdate returns the last update request sent for the database with the
+// given name, or false if it was never updated.
+func (f *FakeDBaaS) LastUpdate(name string) (database.PostgreSQLCreateRequest, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, db := range f.databases {
+		if db.response.Name == name && db.lastUpdate != nil {
+			return *db.lastUpdate, true
+		}
+	}
+
+	return database.PostgreSQLCreateRequest{}, false
+}
+
+// AddDatabase adds a ready PostgreSQL database to a project, as if it was
+// created outside of Terraform, and returns its uuid.
+func (f *FakeDBaaS) AddDatabase(organization, project, name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	db := &fakeDatabase{
+		organization: organization,
+		project:      project,
+		response: database.PostgreSQLGetResponse{
+			Uuid:           newUUID(),
+			CreatedBy:      FakeUser,
+			CreatedAt:      &now,
+			LastModifiedBy: FakeUser,
+			LastModifiedAt: &now,
+			Status:         database.StateReady,
+			Phase:          FakePhaseRunning,
+			ResourceStatus: FakeResourceSync,
+		},
+	}
+	db.apply(database.PostgreSQLCreateRequest{
+		Name: name,
+		ServiceConfig: database.PostgreSQLServiceConfig{
+			Disksize: ptr(int64(25)),
+			Type:     "database",
+			Flavor:   "SCS-2V-4-50n",
+			Region:   "dus2",
+		},
+		ApplicationConfig: database.PostgreSQLApplicationConfig{
+			Type:      "postgresql",
+			Instances: ptr(int64(1)),
+			Version:   "17.4",
+			PublicNetworking: &database.PostgreSQLPublicNetworking{
+				Enabled:      ptr(true),
+				AllowedCidrs: &[]string{"0.0.0.0/0"},
+			},
+		},
+	}, f.Features)
+	f.databases[db.response.Uuid] = db
+
+	return db.response.Uuid
+}
+
+// HoldDatabase puts the database with the given name into status and phase
+// and keeps it there until it is updated or deleted. It reports whether the
+// database exists.
+func (f *FakeDBaaS) HoldDatabase(name, status, phase string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, db := range f.databases {
+		if db.response.Name == name {
+			db.pendingPolls = math.MaxInt
+			db.response.Status = status
+			db.response.Phase = phase
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveDatabase deletes the database with the given name immediately, as if
+// it was deleted outside of Terraform. It reports whether it existed.
+func (f *FakeDBaaS) RemoveDatabase(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for uuid, db := range f.databases {
+		if db.response.Name == name {
+			delete(f.databases, uuid)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *FakeDBaaS) createDatabase(w http.ResponseWriter, r *http.Request) {
+	var request database.PostgreSQLCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	db := &fakeDatabase{
+		organization: r.PathValue("organization"),
+		project:      r.PathValue("project"),
+		pendingPolls: f.pollsUntilReady,
+		response: database.PostgreSQLGetResponse{
+			Uuid:           newUUID(),
+			CreatedBy:      FakeUser,
+			CreatedAt:      &now,
+			LastModifiedBy: FakeUser,
+			LastModifiedAt: &now,
+		},
+	}
+	db.apply(request, f.Features)
+	db.setTransitional(FakeStateCreating)
+	f.databases[db.response.Uuid] = db
+
+	writeJSON(w, http.StatusCreated, db.response)
+}
+
+func (f *FakeDBaaS) listDatabases(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	databases := []database.PostgreSQLGetResponse{}
+	for _, db := range f.databases {
+		if db.organization == r.PathValue("organization") && db.project == r.PathValue("project") {
+			databases = append(databases, db.response)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, databases)
+}
+
+func (f *FakeDBaaS) getDatabase(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	db, ok := f.lookup(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, "database not found")
+		return
+	}
+
+	if db.pendingPolls > 0 {
+		db.pendingPolls--
+	} else if db.deleting {
+		delete(f.databases, db.response.Uuid)
+		writeError(w, http.StatusNotFound, "database not found")
+		return
+	} else {
+		db.response.Status = database.StateReady
+		db.response.Phase = FakePhaseRunning
+		db.response.ResourceStatus = FakeResourceSync
+	}
+
+	writeJSON(w, http.StatusOK, db.response)
+}
+
+func (f *FakeDBaaS) updateDatabase(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// apply fills in generated values, so the request is decoded twice to
+	// keep it as sent.
+	var request, sent database.PostgreSQLCreateRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	_ = json.Unmarshal(body, &sent)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	db, ok := f.lookup(r)
+	if !ok || db.deleting {
+		writeError(w, http.StatusNotFound, "database not found")
+		return
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	db.response.LastModifiedBy = FakeUser
+	db.response.LastModifiedAt = &now
+	db.pendingPolls = f.pollsUntilReady
+	db.lastUpdate = &sent
+	db.apply(request, f.Features)
+	db.setTransitional(FakeStateUpdating)
+
+	writeJSON(w, http.StatusOK, db.response)
+}
+
+func (f *FakeDBaaS) deleteDatabase(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	db, ok := f.lookup(r)
+	if !ok {
+		writeError(w, http.StatusNotFound, "database not found")
+		return
+	}
+
+	db.deleting = true
+	db.pendingPolls = f.pollsUntilReady
+	db.setTransitional(FakeStateDeleting)
+
+	writeJSON(w, http.StatusOK, db.response)
+}
+
+func (f *FakeDBaaS) listCatalog(entries func() []CatalogEntry) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		writeJSON(w, http.StatusOK, entries())
+	}
+}
+
+func (f *FakeDBaaS) listFeatures(w http.ResponseWriter, _ *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, f.Features)
+}
+
+func (f *FakeDBaaS) lookup(r *http.Request) (*fakeDatabase, bool) {
+	db, ok := f.databases[r.PathValue("uuid")]
+	if !ok || db.organization != r.PathValue("organization") || db.project != r.PathValue("project") {
+		return nil, false
+	}
+
+	return db, true
+}
+
+// apply copies the requested configuration into the API representation and
+// fills in the values the real API would generate, including the defaults of
+// features that were not requested.
+func (db *fakeDatabase) apply(request database.PostgreSQLCreateRequest, features []database.Feature) {
+	uuid := db.response.Uuid
+
+	db.response.Name = request.Name
+	db.response.Description = request.Description
+	if db.response.Description == nil {
+		db.response.Description = ptr("")
+	}
+
+	serviceConfig := request.ServiceConfig
+	if serviceConfig.MaintenanceWindow == nil {
+		serviceConfig.MaintenanceWindow = db.response.ServiceConfig.MaintenanceWindow
+	}
+	if serviceConfig.MaintenanceWindow == nil {
+		serviceConfig.MaintenanceWindow = &database.PostgreSQLMaintenance{
+			DayOfWeek:   ptr(int64(0)),
+			StartHour:   ptr(int64(2)),
+			StartMinute: ptr(int64(0)),
+		}
+	}
+	db.response.ServiceConfig = serviceConfig
+
+	applicationConfig := request.ApplicationConfig
+	if applicationConfig.Password != "" {
+		db.password = applicationConfig.Password
+	}
+	applicationConfig.Password = ""
+	if applicationConfig.ScheduledBackups == nil {
+		applicationConfig.ScheduledBackups = db.response.ApplicationConfig.ScheduledBackups
+	}
+	if applicationConfig.ScheduledBackups == nil {
+		applicationConfig.ScheduledBackups = &database.PostgreSQLBackupSchedule{
+			Retention: ptr(int64(7)),
+			Schedule: &database.PostgreSQLBackupScheduleConfig{
+				Hour:   ptr(int64(3)),
+				Minute: ptr(int64(0)),
+			},
+		}
+	}
+
+	privateNetworking := &database.PostgreSQLPrivateNetworking{
+		AllowedCidrs: &[]string{},
+		Enabled:      ptr(false),
+	}
+	if applicationConfig.PrivateNetworking != nil {
+		privateNetworking = applicationConfig.PrivateNetworking
+		if privateNetworking.AllowedCidrs == nil {
+			privateNetworking.AllowedCidrs = &[]string{}
+		}
+		if privateNetworking.Enabled != nil && *privateNetworking.Enabled {
+			privateNetworking.Hostname = ptr(uuid + ".postgresql-private.syseleven.services")
+			privateNetworking.IpAddress = ptr("10.240.0.10")
+			privateNetworking.SharedNetworkId = ptr("fake-network-" + uuid)
+			privateNetworking.SharedSubnetId = ptr("fake-subnet-" + uuid)
+		}
+	}
+	applicationConfig.PrivateNetworking = privateNetworking
+
+	publicNetworking := &database.PostgreSQLPublicNetworking{
+		AllowedCidrs: &[]string{},
+		Enabled:      ptr(false),
+	}
+	if applicationConfig.PublicNetworking != nil {
+		publicNetworking = applicationConfig.PublicNetworking
+		if publicNetworking.AllowedCidrs == nil {
+			publicNetworking.AllowedCidrs = &[]string{}
+		}
+		if publicNetworking.Enabled != nil && *publicNetworking.Enabled {
+			publicNetworking.Hostname = ptr(uuid + ".postgresql.syseleven.services")
+			publicNetworking.IpAddress = ptr("203.0.113.10")
+		}
+	}
+	applicationConfig.PublicNetworking = publicNetworking
+
+	if len(features) > 0 || applicationConfig.Features != nil {
+		applied := map[string]database.PostgreSQLApplicationConfigFeatures{}
+		for _, feature := range features {
+			applied[feature.Id] = feature.Default
+		}
+		if applicationConfig.Features != nil {
+			maps.Copy(applied, *applicationConfig.Features)
+		}
+		applicationConfig.Features = &applied
+	}
+
+	db.response.ApplicationConfig = applicationConfig
+}
+
+func (db *fakeDatabase) setTransitional(status string) {
+	db.response.Status = status
+	db.response.Phase = status
+	db.response.ResourceStatus = FakeResourceBusy
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]string{"detail": detail})
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}